@@ -1 +1,7 @@
 # Include custom targets and environment variables here
+
+## Generates the piece sprite sheet used to render PNG boards.
+.PHONY: sprites
+sprites:
+	cd build && $(GO) build -o bin/sprites ./sprites
+	./build/bin/sprites
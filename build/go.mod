@@ -5,7 +5,10 @@ go 1.12
 require (
 	github.com/go-git/go-git/v5 v5.1.0
 	github.com/mattermost/mattermost-server/v5 v5.3.2-0.20200924100636-e726b0426826
+	github.com/notnil/chess v1.5.0
 	github.com/pkg/errors v0.9.1
+	github.com/srwiley/oksvg v0.0.0-20221011165216-be6e8873101c
+	github.com/srwiley/rasterx v0.0.0-20220730225603-2ab79fcdd4ef
 	github.com/stretchr/testify v1.6.1
 	sigs.k8s.io/yaml v1.2.0
 )
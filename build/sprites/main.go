@@ -0,0 +1,179 @@
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/png"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/notnil/chess"
+	chessImage "github.com/notnil/chess/image"
+	"github.com/pkg/errors"
+	"github.com/srwiley/oksvg"
+	"github.com/srwiley/rasterx"
+)
+
+// spriteSize is the size in pixels of each piece in the sprite sheet. Boards are
+// rendered by scaling the sprites down, so it should be at least as large as the
+// largest square the plugin serves.
+const spriteSize = 128
+
+const pieceSetsGoFileHeader = `// This file is automatically generated. Do not modify it manually.
+
+package main
+
+// pieceSpriteSize is the size in pixels of each piece of the sprite sheets.
+const pieceSpriteSize = %d
+
+// pieceSetSources holds, for each piece set, the SVG markup of the pieces drawn in
+// a 45x45 box, and the base64 encoded PNG sprite sheet of the pieces. The first
+// row of the sheet holds the white pieces and the second one the black pieces,
+// each ordered king, queen, rook, bishop, knight, pawn, like the markup.
+var pieceSetSources = map[string]pieceSetSource{
+`
+
+// libraryPieceSet is the name of the set drawn by the image library.
+const libraryPieceSet = "cburnett"
+
+// piecesDir holds a directory of SVG sources for each of the other sets, named
+// after the color and the piece, e.g. wK.svg.
+const piecesDir = "build/sprites/pieces"
+
+// spritePieces are the rows of the sprite sheet.
+var spritePieces = [][]chess.Piece{
+	{chess.WhiteKing, chess.WhiteQueen, chess.WhiteRook, chess.WhiteBishop, chess.WhiteKnight, chess.WhitePawn},
+	{chess.BlackKing, chess.BlackQueen, chess.BlackRook, chess.BlackBishop, chess.BlackKnight, chess.BlackPawn},
+}
+
+var pieceFileNames = map[chess.PieceType]string{
+	chess.King:   "K",
+	chess.Queen:  "Q",
+	chess.Rook:   "R",
+	chess.Bishop: "B",
+	chess.Knight: "N",
+	chess.Pawn:   "P",
+}
+
+var (
+	pieceSVGRegexp    = regexp.MustCompile(`(?s)<svg xmlns="http://www.w3.org/2000/svg" version="1.1"[^>]*>.*?</svg>`)
+	pieceHeaderRegexp = regexp.MustCompile(`<svg xmlns="http://www.w3.org/2000/svg" version="1.1"[^>]*>`)
+)
+
+func main() {
+	err := generate("server/piece_sets.go")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func generate(path string) error {
+	sets := []string{libraryPieceSet}
+	dirs, err := ioutil.ReadDir(piecesDir)
+	if err != nil {
+		return err
+	}
+	for _, dir := range dirs {
+		if dir.IsDir() {
+			sets = append(sets, dir.Name())
+		}
+	}
+
+	out := &bytes.Buffer{}
+	fmt.Fprintf(out, pieceSetsGoFileHeader, spriteSize)
+	for _, set := range sets {
+		markup, sheet, err := generateSet(set)
+		if err != nil {
+			return errors.Wrapf(err, "failed to generate the %s piece set", set)
+		}
+
+		fmt.Fprintf(out, "\t%q: {\n\t\tSVG: [12]string{\n", set)
+		for _, m := range markup {
+			fmt.Fprintf(out, "\t\t\t%q,\n", m)
+		}
+		fmt.Fprintf(out, "\t\t},\n\t\tPNG: %q,\n\t},\n", sheet)
+	}
+	out.WriteString("}\n")
+
+	return ioutil.WriteFile(path, out.Bytes(), 0600)
+}
+
+// generateSet returns the markup of the pieces of the set and its sprite sheet.
+func generateSet(set string) ([]string, string, error) {
+	markup := []string{}
+	sheet := image.NewNRGBA(image.Rect(0, 0, len(spritePieces[0])*spriteSize, len(spritePieces)*spriteSize))
+	for row, pieces := range spritePieces {
+		for col, piece := range pieces {
+			pieceSVG, err := loadPiece(set, piece)
+			if err != nil {
+				return nil, "", errors.Wrapf(err, "failed to load %s", piece.String())
+			}
+
+			sprite, err := renderPiece(pieceSVG)
+			if err != nil {
+				return nil, "", errors.Wrapf(err, "failed to render %s", piece.String())
+			}
+
+			at := image.Pt(col*spriteSize, row*spriteSize)
+			draw.Draw(sheet, sprite.Bounds().Add(at), sprite, image.Point{}, draw.Src)
+
+			inner := pieceHeaderRegexp.ReplaceAllString(pieceSVG, "")
+			inner = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(inner), "</svg>"))
+			markup = append(markup, inner)
+		}
+	}
+
+	buf := &bytes.Buffer{}
+	encoder := png.Encoder{CompressionLevel: png.BestCompression}
+	if err := encoder.Encode(buf, sheet); err != nil {
+		return nil, "", errors.Wrap(err, "failed to encode sprite sheet")
+	}
+
+	return markup, base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+// loadPiece returns the SVG of the piece, in a 45x45 box.
+func loadPiece(set string, piece chess.Piece) (string, error) {
+	if set != libraryPieceSet {
+		b, err := ioutil.ReadFile(filepath.Join(piecesDir, set, piece.Color().String()+pieceFileNames[piece.Type()]+".svg"))
+		if err != nil {
+			return "", err
+		}
+		return string(b), nil
+	}
+
+	board := chess.NewBoard(map[chess.Square]chess.Piece{chess.A8: piece})
+	buf := &bytes.Buffer{}
+	if err := chessImage.SVG(buf, board); err != nil {
+		return "", err
+	}
+
+	pieceSVG := pieceSVGRegexp.FindString(buf.String())
+	if pieceSVG == "" {
+		return "", errors.New("piece not found in the board image")
+	}
+	pieceSVG = pieceHeaderRegexp.ReplaceAllString(pieceSVG, `<svg xmlns="http://www.w3.org/2000/svg" version="1.1" width="45" height="45" viewBox="0 0 45 45">`)
+	return strings.Replace(pieceSVG, ":000000", ":#000000", -1), nil
+}
+
+// renderPiece rasterizes the SVG of a piece.
+func renderPiece(pieceSVG string) (image.Image, error) {
+	icon, err := oksvg.ReadIconStream(strings.NewReader(pieceSVG))
+	if err != nil {
+		return nil, err
+	}
+	icon.SetTarget(0, 0, spriteSize, spriteSize)
+
+	sprite := image.NewRGBA(image.Rect(0, 0, spriteSize, spriteSize))
+	scanner := rasterx.NewScannerGV(spriteSize, spriteSize, sprite, sprite.Bounds())
+	icon.Draw(rasterx.NewDasher(spriteSize, spriteSize, scanner), 1)
+
+	return sprite, nil
+}
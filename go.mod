@@ -10,4 +10,6 @@ require (
 	github.com/mattermost/mattermost-server/v5 v5.32.1
 	github.com/notnil/chess v1.5.0
 	github.com/pkg/errors v0.9.1
+	github.com/stretchr/testify v1.7.0
+	golang.org/x/image v0.0.0-20210220032944-ac19c3e999fb
 )
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"sort"
+
+	"github.com/notnil/chess"
+)
+
+// ActiveGame summarizes an in-progress game from the point of view of one of its players.
+type ActiveGame struct {
+	ID         string
+	PostID     string
+	OpponentID string
+	Color      chess.Color
+	MoveNumber int
+	YourTurn   bool
+}
+
+func (gm *GameManager) addActiveGame(game *Game) {
+	for _, index := range []string{allActiveGamesIndex, activeGamesIndex(game.WhiteID), activeGamesIndex(game.BlackID)} {
+		err := gm.store.AddToIndex(index, game.ID)
+		if err != nil {
+			gm.api.LogError("could not add active game", "index", index, "error", err.Error())
+		}
+	}
+}
+
+func (gm *GameManager) removeActiveGame(game *Game) {
+	for _, index := range []string{allActiveGamesIndex, activeGamesIndex(game.WhiteID), activeGamesIndex(game.BlackID)} {
+		err := gm.store.RemoveFromIndex(index, game.ID)
+		if err != nil {
+			gm.api.LogError("could not remove active game", "index", index, "error", err.Error())
+		}
+	}
+}
+
+// GetActiveGames returns the in-progress games of userID, the ones waiting on the user first.
+func (gm *GameManager) GetActiveGames(userID string) ([]*ActiveGame, error) {
+	ids, err := gm.store.GetIndex(activeGamesIndex(userID))
+	if err != nil {
+		return nil, err
+	}
+
+	games := []*ActiveGame{}
+	for _, id := range ids {
+		game, err := gm.store.GetGame(id)
+		if err != nil && err != ErrNotFound {
+			gm.api.LogDebug("could not get active game", "id", id, "error", err.Error())
+			continue
+		}
+		if err == ErrNotFound || game.IsOver() {
+			_ = gm.store.RemoveFromIndex(activeGamesIndex(userID), id)
+			continue
+		}
+
+		active := &ActiveGame{
+			ID:         id,
+			PostID:     game.PostID,
+			OpponentID: game.BlackID,
+			Color:      game.ColorOf(userID),
+			MoveNumber: len(game.Moves())/2 + 1,
+			YourTurn:   game.PlayerToMove() == userID,
+		}
+		if active.Color == chess.Black {
+			active.OpponentID = game.WhiteID
+		}
+
+		games = append(games, active)
+	}
+
+	sort.SliceStable(games, func(i, j int) bool {
+		return games[i].YourTurn && !games[j].YourTurn
+	})
+
+	return games, nil
+}
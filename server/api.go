@@ -3,9 +3,11 @@ package main
 import (
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/mattermost/mattermost-plugin-api/experimental/common"
+	"github.com/mattermost/mattermost-plugin-api/i18n"
 	"github.com/mattermost/mattermost-server/v5/model"
 )
 
@@ -16,7 +18,15 @@ func (p *Plugin) initializeAPI() {
 	p.router.HandleFunc("/movement/{id}", p.handleMovement).Methods(http.MethodPost)
 	p.router.HandleFunc("/resign/{id}", p.handleResign).Methods(http.MethodPost)
 	p.router.HandleFunc("/resignation/{id}", p.handleResignation).Methods(http.MethodPost)
-	p.router.HandleFunc("/image.svg", p.handleImage).Methods(http.MethodGet)
+	p.router.HandleFunc("/claim/{id}", p.handleClaim).Methods(http.MethodPost)
+	p.router.HandleFunc("/abandon/{id}", p.handleAbandon).Methods(http.MethodPost)
+	p.router.HandleFunc("/abort/{id}", p.handleAbort).Methods(http.MethodPost)
+	p.router.HandleFunc("/rematch/{id}", p.handleRematch).Methods(http.MethodPost)
+	p.router.HandleFunc("/rematch/{id}/accept", p.handleRematchAccept).Methods(http.MethodPost)
+	p.router.HandleFunc("/rematch/{id}/decline", p.handleRematchDecline).Methods(http.MethodPost)
+	p.router.HandleFunc("/browse/{id}", p.handleBrowse).Methods(http.MethodPost)
+	p.router.HandleFunc("/image.{format:svg|png}", p.handleImage).Methods(http.MethodGet)
+	p.router.HandleFunc("/pgn/{id}", p.handlePGN).Methods(http.MethodGet)
 }
 
 func (p *Plugin) handleMove(w http.ResponseWriter, r *http.Request) {
@@ -35,8 +45,13 @@ func (p *Plugin) handleMove(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if !p.gameManager.CanMove(gameID, userID) {
-		common.SlackAttachmentError(w, "Cannot move.")
+	gm := p.gameManager
+	l := gm.userLocalizer(userID)
+	if !gm.CanMove(gameID, userID) {
+		common.SlackAttachmentError(w, gm.localize(l, &i18n.Message{
+			ID:    "chess.dialog.cannot_move",
+			Other: "Cannot move.",
+		}, nil))
 		return
 	}
 
@@ -45,16 +60,30 @@ func (p *Plugin) handleMove(w http.ResponseWriter, r *http.Request) {
 		TriggerId: request.TriggerId,
 		URL:       fmt.Sprintf("%s/plugins/%s/movement/%s", *baseURL, manifest.Id, gameID),
 		Dialog: model.Dialog{
-			Title: "Make your move",
-			IntroductionText: "Write your movement in default Algeabric Notation.\n\n![board]" +
-				"(" + p.gameManager.GetBoardLink(gameID) + ")",
-			SubmitLabel: "Move",
+			Title: gm.localize(l, &i18n.Message{
+				ID:    "chess.dialog.move_title",
+				Other: "Make your move",
+			}, nil),
+			IntroductionText: gm.localize(l, &i18n.Message{
+				ID:    "chess.dialog.move_intro",
+				Other: "Write your move in algebraic notation.",
+			}, nil) + "\n\n" + gm.GetBoardView(gameID, userID),
+			SubmitLabel: gm.localize(l, &i18n.Message{
+				ID:    "chess.dialog.move_submit",
+				Other: "Move",
+			}, nil),
 			Elements: []model.DialogElement{
 				{
-					DisplayName: "Movement",
-					Name:        "movement",
-					Type:        "text",
-					HelpText:    "Ex. f3, Qh4",
+					DisplayName: gm.localize(l, &i18n.Message{
+						ID:    "chess.dialog.move_field",
+						Other: "Movement",
+					}, nil),
+					Name: "movement",
+					Type: "text",
+					HelpText: gm.localize(l, &i18n.Message{
+						ID:    "chess.dialog.move_help",
+						Other: "Ex. {{.Examples}}",
+					}, map[string]interface{}{"Examples": "f3, " + gm.userNotation(userID).format("Qh4")}),
 				},
 			},
 		},
@@ -82,8 +111,10 @@ func (p *Plugin) handleResign(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if !p.gameManager.IsPlayingGame(gameID, userID) {
-		common.SlackAttachmentError(w, "Error: you are not playing this game")
+	gm := p.gameManager
+	l := gm.userLocalizer(userID)
+	if !gm.IsPlayingGame(gameID, userID) {
+		common.SlackAttachmentError(w, p.errorText(userID, newUserError(messageNotPlaying, nil)))
 		return
 	}
 
@@ -92,14 +123,26 @@ func (p *Plugin) handleResign(w http.ResponseWriter, r *http.Request) {
 		TriggerId: request.TriggerId,
 		URL:       fmt.Sprintf("%s/plugins/%s/resignation/%s", *baseURL, manifest.Id, gameID),
 		Dialog: model.Dialog{
-			Title:            "Resign this game?",
-			IntroductionText: "Are you sure you want to resign this game?",
-			SubmitLabel:      "Resign",
+			Title: gm.localize(l, &i18n.Message{
+				ID:    "chess.dialog.resign_title",
+				Other: "Resign this game?",
+			}, nil),
+			IntroductionText: gm.localize(l, &i18n.Message{
+				ID:    "chess.dialog.resign_intro",
+				Other: "Are you sure you want to resign this game?",
+			}, nil),
+			SubmitLabel: gm.localize(l, &i18n.Message{
+				ID:    "chess.dialog.resign_submit",
+				Other: "Resign",
+			}, nil),
 		},
 	})
 
 	if appErr != nil {
-		common.SlackAttachmentError(w, "Error: could not open the interactive dialog, "+appErr.Error())
+		common.SlackAttachmentError(w, gm.localize(l, &i18n.Message{
+			ID:    "chess.dialog.open_error",
+			Other: "Error: could not open the interactive dialog, {{.Error}}",
+		}, map[string]interface{}{"Error": appErr.Error()}))
 		return
 	}
 
@@ -126,11 +169,13 @@ func (p *Plugin) handleMovement(w http.ResponseWriter, r *http.Request) {
 
 	post, err := p.gameManager.Move(gameID, userID, movement)
 	if err != nil {
-		interactiveDialogError(w, "Error: "+err.Error())
+		interactiveDialogError(w, p.errorText(userID, err))
 		return
 	}
 
-	_, _ = p.API.UpdatePost(post)
+	if _, appErr := p.API.UpdatePost(post); appErr != nil {
+		p.API.LogError("could not update the game post", "error", appErr.Error())
+	}
 
 	_, _ = w.Write((&model.SubmitDialogResponse{}).ToJson())
 }
@@ -147,15 +192,162 @@ func (p *Plugin) handleResignation(w http.ResponseWriter, r *http.Request) {
 
 	post, err := p.gameManager.Resign(gameID, userID)
 	if err != nil {
-		interactiveDialogError(w, "Error: "+err.Error())
+		interactiveDialogError(w, p.errorText(userID, err))
 		return
 	}
 
-	_, _ = p.API.UpdatePost(post)
+	if _, appErr := p.API.UpdatePost(post); appErr != nil {
+		p.API.LogError("could not update the game post", "error", appErr.Error())
+	}
 
 	_, _ = w.Write((&model.SubmitDialogResponse{}).ToJson())
 }
 
+func (p *Plugin) handleClaim(w http.ResponseWriter, r *http.Request) {
+	p.handleAbandonedGame(w, r, p.gameManager.ClaimAbandonment, &i18n.Message{
+		ID:    "chess.abandonment.claimed",
+		Other: "You claimed the win by abandonment.",
+	})
+}
+
+func (p *Plugin) handleAbandon(w http.ResponseWriter, r *http.Request) {
+	p.handleAbandonedGame(w, r, p.gameManager.AbortAbandoned, &i18n.Message{
+		ID:    "chess.abandonment.aborted",
+		Other: "You aborted the game.",
+	})
+}
+
+func (p *Plugin) handleAbandonedGame(w http.ResponseWriter, r *http.Request, action func(id, player string, abandonAfter time.Duration) (*model.Post, error), message *i18n.Message) {
+	vars := mux.Vars(r)
+	gameID := vars["id"]
+
+	userID := r.Header.Get("Mattermost-User-ID")
+	if userID == "" {
+		common.SlackAttachmentError(w, "Error: Not authorized")
+		return
+	}
+
+	request := model.PostActionIntegrationRequestFromJson(r.Body)
+	if request == nil {
+		common.SlackAttachmentError(w, "Error: invalid request")
+		return
+	}
+
+	post, err := action(gameID, userID, p.getConfiguration().abandonmentAfter())
+	if err != nil {
+		common.SlackAttachmentError(w, p.errorText(userID, err))
+		return
+	}
+
+	if _, appErr := p.API.UpdatePost(post); appErr != nil {
+		p.API.LogError("could not update the game post", "error", appErr.Error())
+	}
+
+	_, _ = w.Write((&model.PostActionIntegrationResponse{
+		Update: &model.Post{
+			Message: p.gameManager.localize(p.gameManager.userLocalizer(userID), message, nil),
+			Props:   model.StringInterface{},
+		},
+	}).ToJson())
+}
+
+func (p *Plugin) handleAbort(w http.ResponseWriter, r *http.Request) {
+	p.handlePostAction(w, r, p.gameManager.Abort)
+}
+
+func (p *Plugin) handleRematch(w http.ResponseWriter, r *http.Request) {
+	p.handlePostAction(w, r, p.gameManager.OfferRematch)
+}
+
+func (p *Plugin) handleRematchAccept(w http.ResponseWriter, r *http.Request) {
+	p.handlePostAction(w, r, p.gameManager.AcceptRematch)
+}
+
+func (p *Plugin) handleRematchDecline(w http.ResponseWriter, r *http.Request) {
+	p.handlePostAction(w, r, p.gameManager.DeclineRematch)
+}
+
+// handlePostAction runs a game action triggered by a button on the game post,
+// and updates the post with the result.
+func (p *Plugin) handlePostAction(w http.ResponseWriter, r *http.Request, action func(id, player string) (*model.Post, error)) {
+	vars := mux.Vars(r)
+	gameID := vars["id"]
+
+	userID := r.Header.Get("Mattermost-User-ID")
+	if userID == "" {
+		common.SlackAttachmentError(w, "Error: Not authorized")
+		return
+	}
+
+	request := model.PostActionIntegrationRequestFromJson(r.Body)
+	if request == nil {
+		common.SlackAttachmentError(w, "Error: invalid request")
+		return
+	}
+
+	post, err := action(gameID, userID)
+	if err != nil {
+		common.SlackAttachmentError(w, p.errorText(userID, err))
+		return
+	}
+
+	if _, appErr := p.API.UpdatePost(post); appErr != nil {
+		p.API.LogError("could not update the game post", "error", appErr.Error())
+	}
+
+	_, _ = w.Write((&model.PostActionIntegrationResponse{}).ToJson())
+}
+
+// handleBrowse shows a position of a finished game to the user who clicked one of
+// the browse buttons. The shared post is left alone: browsing from it opens an
+// ephemeral post, which the next clicks update.
+func (p *Plugin) handleBrowse(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	gameID := vars["id"]
+
+	userID := r.Header.Get("Mattermost-User-ID")
+	if userID == "" {
+		common.SlackAttachmentError(w, "Error: Not authorized")
+		return
+	}
+
+	request := model.PostActionIntegrationRequestFromJson(r.Body)
+	if request == nil {
+		common.SlackAttachmentError(w, "Error: invalid request")
+		return
+	}
+
+	ply, ok := request.Context[browsePlyContext].(float64)
+	if !ok {
+		common.SlackAttachmentError(w, "Error: invalid request")
+		return
+	}
+
+	post, err := p.gameManager.BrowseGame(gameID, userID, int(ply))
+	if err != nil {
+		common.SlackAttachmentError(w, p.errorText(userID, err))
+		return
+	}
+
+	if p.gameManager.IsGamePost(gameID, request.PostId) {
+		p.API.SendEphemeralPost(userID, post)
+	} else {
+		post.Id = request.PostId
+		p.API.UpdateEphemeralPost(userID, post)
+	}
+
+	_, _ = w.Write((&model.PostActionIntegrationResponse{}).ToJson())
+}
+
+// errorText returns the error to show to the user, in their language.
+func (p *Plugin) errorText(userID string, err error) string {
+	l := p.gameManager.userLocalizer(userID)
+	return p.gameManager.localize(l, &i18n.Message{
+		ID:    "chess.error",
+		Other: "Error: {{.Error}}",
+	}, map[string]interface{}{"Error": p.gameManager.localizeError(l, err)})
+}
+
 func interactiveDialogError(w http.ResponseWriter, message string) {
 	resp := model.SubmitDialogResponse{
 		Error: message,
@@ -171,16 +363,59 @@ func (p *Plugin) handleImage(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	format := mux.Vars(r)["format"]
 	query := r.URL.Query()
-	fen := query.Get("fen")
-	if fen == "" {
+	if !verifyImage(p.gameManager.imageKey, format, query) {
+		http.Error(w, "Invalid signature", http.StatusForbidden)
+		return
+	}
+
+	img, err := parseBoardImage(query, format)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if img.FEN == "" {
 		common.SlackAttachmentError(w, "Error: missing board definition")
 		return
 	}
-	from := query.Get("from")
-	to := query.Get("to")
-	check := query.Get("check")
-	capture := query.Get("capture")
 
-	p.gameManager.PrintImage(w, fen, from, to, check, capture)
+	rendered, err := p.gameManager.GetBoardImage(img)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// The default theme is not part of the URL and can change, so clients revalidate
+	// every time and only download the image again when its ETag changed.
+	w.Header().Set("Cache-Control", "private, no-cache")
+	w.Header().Set("ETag", rendered.ETag)
+	if r.Header.Get("If-None-Match") == rendered.ETag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Type", rendered.ContentType)
+	_, _ = w.Write(rendered.Body)
+}
+
+func (p *Plugin) handlePGN(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	gameID := vars["id"]
+
+	userID := r.Header.Get("Mattermost-User-ID")
+	if userID == "" {
+		http.Error(w, "Not authorized", http.StatusUnauthorized)
+		return
+	}
+
+	pgn, err := p.gameManager.ExportGame(gameID, userID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/x-chess-pgn")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s.pgn\"", gameID))
+	_, _ = w.Write([]byte(pgn))
 }
@@ -0,0 +1,147 @@
+package main
+
+import (
+	"sort"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/notnil/chess"
+	"github.com/pkg/errors"
+)
+
+// ArchivedGame is the record kept for every finished game, so it survives
+// the channel starting a new one.
+type ArchivedGame struct {
+	ID        string        `json:"id"`
+	ChannelID string        `json:"channel_id"`
+	PostID    string        `json:"post_id"`
+	WhiteID   string        `json:"white_id"`
+	BlackID   string        `json:"black_id"`
+	Outcome   chess.Outcome `json:"outcome"`
+	Method    chess.Method  `json:"method"`
+	// Termination is set when the game did not end over the board, e.g. "abandoned" or "aborted".
+	Termination string `json:"termination,omitempty"`
+	Plies       int    `json:"plies"`
+	Opening     string `json:"opening"`
+	EndedAt     int64  `json:"ended_at"`
+	PGN         string `json:"pgn"`
+}
+
+// Opponent returns the ID of the other player of the game.
+func (a *ArchivedGame) Opponent(userID string) string {
+	if a.WhiteID == userID {
+		return a.BlackID
+	}
+	return a.WhiteID
+}
+
+// Color returns the color userID played with.
+func (a *ArchivedGame) Color(userID string) chess.Color {
+	if a.WhiteID == userID {
+		return chess.White
+	}
+	return chess.Black
+}
+
+// Won reports whether userID won the game.
+func (a *ArchivedGame) Won(userID string) bool {
+	return (a.Outcome == chess.WhiteWon && a.WhiteID == userID) || (a.Outcome == chess.BlackWon && a.BlackID == userID)
+}
+
+// Lost reports whether userID lost the game.
+func (a *ArchivedGame) Lost(userID string) bool {
+	return (a.Outcome == chess.WhiteWon && a.BlackID == userID) || (a.Outcome == chess.BlackWon && a.WhiteID == userID)
+}
+
+func (gm *GameManager) archiveGame(game *Game) error {
+	archived := &ArchivedGame{
+		ID:          game.ID,
+		ChannelID:   game.ChannelID,
+		PostID:      game.PostID,
+		WhiteID:     game.WhiteID,
+		BlackID:     game.BlackID,
+		Outcome:     game.Outcome(),
+		Method:      game.Method(),
+		Termination: game.Termination,
+		Plies:       len(game.Moves()),
+		Opening:     game.OpeningName(),
+		EndedAt:     model.GetMillis(),
+		PGN:         game.String(),
+	}
+
+	err := gm.store.SaveArchivedGame(archived)
+	if err != nil {
+		return err
+	}
+
+	for _, userID := range []string{archived.WhiteID, archived.BlackID} {
+		err = gm.store.AddToIndex(userGamesIndex(userID), archived.ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (gm *GameManager) GetArchivedGame(gameID string) (*ArchivedGame, error) {
+	archived, err := gm.store.GetArchivedGame(gameID)
+	if err == ErrNotFound {
+		return nil, errors.Errorf("game %s not found", gameID)
+	}
+	return archived, err
+}
+
+// GetArchivedGamesPage returns a page of the finished games of userID, newest first,
+// and whether there are more pages after it.
+func (gm *GameManager) GetArchivedGamesPage(userID string, page, perPage int) ([]*ArchivedGame, bool, error) {
+	ids, err := gm.store.GetIndex(userGamesIndex(userID))
+	if err != nil {
+		return nil, false, err
+	}
+
+	games := []*ArchivedGame{}
+	start := len(ids) - 1 - page*perPage
+	end := start - perPage
+	if end < -1 {
+		end = -1
+	}
+	for i := start; i > end; i-- {
+		archived, err := gm.GetArchivedGame(ids[i])
+		if err != nil {
+			gm.api.LogDebug("could not get archived game", "id", ids[i], "error", err.Error())
+			continue
+		}
+		games = append(games, archived)
+	}
+
+	return games, end > -1, nil
+}
+
+func (gm *GameManager) isArchived(gameID string) bool {
+	_, err := gm.store.GetArchivedGame(gameID)
+	return err == nil
+}
+
+// GetArchivedGames returns every finished game of userID, oldest first.
+func (gm *GameManager) GetArchivedGames(userID string) ([]*ArchivedGame, error) {
+	ids, err := gm.store.GetIndex(userGamesIndex(userID))
+	if err != nil {
+		return nil, err
+	}
+
+	games := []*ArchivedGame{}
+	for _, id := range ids {
+		archived, err := gm.GetArchivedGame(id)
+		if err != nil {
+			gm.api.LogDebug("could not get archived game", "id", id, "error", err.Error())
+			continue
+		}
+		games = append(games, archived)
+	}
+
+	sort.Slice(games, func(i, j int) bool {
+		return games[i].EndedAt < games[j].EndedAt
+	})
+
+	return games, nil
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetArchivedGamesPage(t *testing.T) {
+	const userID = "player"
+	api := setupTestAPI(t)
+	gm, store := setupTestGameManager(t, api)
+
+	// Games 0 to 4 are archived in order, so game 4 is the newest.
+	for i := 0; i < 5; i++ {
+		id := "game" + strconv.Itoa(i)
+		require.NoError(t, store.SaveArchivedGame(&ArchivedGame{ID: id, WhiteID: userID, EndedAt: int64(i)}))
+		require.NoError(t, store.AddToIndex(userGamesIndex(userID), id))
+	}
+	// A game in the index whose archive entry is missing is skipped.
+	require.NoError(t, store.AddToIndex(userGamesIndex(userID), "missing"))
+
+	for _, tc := range []struct {
+		name     string
+		page     int
+		perPage  int
+		expected []string
+		more     bool
+	}{
+		// The missing game still takes its slot, so pages stay stable.
+		{name: "first page", page: 0, perPage: 2, expected: []string{"game4"}, more: true},
+		{name: "second page", page: 1, perPage: 2, expected: []string{"game3", "game2"}, more: true},
+		{name: "last page", page: 2, perPage: 2, expected: []string{"game1", "game0"}},
+		{name: "past the end", page: 3, perPage: 2, expected: []string{}},
+		{name: "everything", page: 0, perPage: 10, expected: []string{"game4", "game3", "game2", "game1", "game0"}},
+		{name: "exact fit", page: 0, perPage: 6, expected: []string{"game4", "game3", "game2", "game1", "game0"}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			games, more, err := gm.GetArchivedGamesPage(userID, tc.page, tc.perPage)
+			require.NoError(t, err)
+
+			ids := []string{}
+			for _, g := range games {
+				ids = append(ids, g.ID)
+			}
+			assert.Equal(t, tc.expected, ids)
+			assert.Equal(t, tc.more, more)
+		})
+	}
+}
+
+func TestGetArchivedGames(t *testing.T) {
+	const userID = "player"
+	api := setupTestAPI(t)
+	gm, store := setupTestGameManager(t, api)
+
+	for i, endedAt := range []int64{30, 10, 20} {
+		id := "game" + strconv.Itoa(i)
+		require.NoError(t, store.SaveArchivedGame(&ArchivedGame{ID: id, BlackID: userID, EndedAt: endedAt}))
+		require.NoError(t, store.AddToIndex(userGamesIndex(userID), id))
+	}
+
+	games, err := gm.GetArchivedGames(userID)
+	require.NoError(t, err)
+	require.Len(t, games, 3)
+	assert.Equal(t, []string{"game1", "game2", "game0"}, []string{games[0].ID, games[1].ID, games[2].ID})
+
+	games, err = gm.GetArchivedGames("someone else")
+	require.NoError(t, err)
+	assert.Empty(t, games)
+}
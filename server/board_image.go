@@ -0,0 +1,449 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"image/color"
+	"image/png"
+	"math"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/mattermost/mattermost-plugin-api/i18n"
+	"github.com/notnil/chess"
+	"github.com/pkg/errors"
+)
+
+const (
+	boardSquareSize       = 45
+	boardCoordinateMargin = 20
+
+	boardImageSVG = "svg"
+	boardImagePNG = "png"
+)
+
+// BoardImage describes a board image served by the plugin. It maps to the query
+// parameters of the image endpoint.
+type BoardImage struct {
+	FEN     string
+	From    string
+	To      string
+	Check   string
+	Capture string
+	// Orientation is the side shown at the bottom of the board.
+	Orientation chess.Color
+	// Coordinates adds the rank and file labels around the board.
+	Coordinates bool
+	// Format is either boardImageSVG or boardImagePNG.
+	Format string
+	// Size is the width of PNG images in pixels. Zero uses the default size.
+	Size int
+	// Theme and Pieces are the names of the board theme and of the piece set. Empty
+	// names use the defaults.
+	Theme  string
+	Pieces string
+	// Marks and Arrows are drawn over the board, in order.
+	Marks  []SquareMark
+	Arrows []BoardArrow
+}
+
+// SquareMark circles a square of the board.
+type SquareMark struct {
+	Square chess.Square
+	Color  color.Color
+}
+
+// BoardArrow is an arrow drawn from the center of a square to the center of another.
+type BoardArrow struct {
+	From  chess.Square
+	To    chess.Square
+	Color color.Color
+}
+
+// boardImageForGame returns the image of the current position of the game, with
+// the last move highlighted.
+func boardImageForGame(game *chess.Game, orientation chess.Color) *BoardImage {
+	var lastMovement *chess.Move
+	if movements := game.Moves(); len(movements) > 0 {
+		lastMovement = movements[len(movements)-1]
+	}
+	return boardImageForPosition(game.Position(), lastMovement, orientation)
+}
+
+// boardImageForPosition returns the image of the position reached with the last
+// movement, which is highlighted. The last movement is nil for the starting position.
+func boardImageForPosition(position *chess.Position, lastMovement *chess.Move, orientation chess.Color) *BoardImage {
+	img := &BoardImage{
+		FEN:         position.String(),
+		Orientation: orientation,
+		Coordinates: true,
+		Format:      boardImageSVG,
+	}
+
+	if lastMovement != nil {
+		img.From = lastMovement.S1().String()
+		img.To = lastMovement.S2().String()
+		if lastMovement.HasTag(chess.Check) {
+			squareMap := position.Board().SquareMap()
+			for square, piece := range squareMap {
+				if piece.Type() == chess.King && piece.Color() == squareMap[lastMovement.S2()].Color().Other() {
+					img.Check = square.String()
+				}
+			}
+		}
+		if lastMovement.HasTag(chess.Capture) {
+			img.Capture = lastMovement.S2().String()
+			if lastMovement.HasTag(chess.EnPassant) {
+				img.Capture = lastMovement.S2().File().String() + lastMovement.S1().Rank().String()
+			}
+		}
+	}
+
+	return img
+}
+
+// parseBoardImage reads a board image from the query of an image request.
+func parseBoardImage(query url.Values, format string) (*BoardImage, error) {
+	img := &BoardImage{
+		Format:      format,
+		FEN:         query.Get("fen"),
+		From:        query.Get("from"),
+		To:          query.Get("to"),
+		Check:       query.Get("check"),
+		Capture:     query.Get("capture"),
+		Theme:       query.Get("theme"),
+		Pieces:      query.Get("pieces"),
+		Orientation: chess.White,
+		Coordinates: true,
+	}
+	if query.Get("orientation") == "black" {
+		img.Orientation = chess.Black
+	}
+	if coordinates, err := strconv.ParseBool(query.Get("coordinates")); err == nil {
+		img.Coordinates = coordinates
+	}
+	if size, err := strconv.Atoi(query.Get("size")); err == nil {
+		img.Size = size
+	}
+
+	var err error
+	img.Arrows, err = parseArrows(query.Get("arrows"))
+	if err != nil {
+		return nil, err
+	}
+	img.Marks, err = parseMarks(query.Get("marks"))
+	if err != nil {
+		return nil, err
+	}
+
+	return img, nil
+}
+
+// query returns the query parameters of the image.
+func (img *BoardImage) query() url.Values {
+	q := url.Values{}
+	q.Set("fen", img.FEN)
+	q.Set("from", img.From)
+	q.Set("to", img.To)
+	q.Set("check", img.Check)
+	q.Set("capture", img.Capture)
+	q.Set("orientation", strings.ToLower(colorName(img.Orientation)))
+	q.Set("coordinates", strconv.FormatBool(img.Coordinates))
+	if img.Theme != "" {
+		q.Set("theme", img.Theme)
+	}
+	if img.Pieces != "" {
+		q.Set("pieces", img.Pieces)
+	}
+	if img.Format == boardImagePNG && img.Size != 0 {
+		q.Set("size", strconv.Itoa(img.Size))
+	}
+	if len(img.Arrows) > 0 {
+		q.Set("arrows", formatArrows(img.Arrows))
+	}
+	if len(img.Marks) > 0 {
+		q.Set("marks", formatMarks(img.Marks))
+	}
+	return q
+}
+
+// markRadius and markWidth are the size of the circle marking a square, relative
+// to the size of the square.
+const (
+	markRadius = 0.45
+	markWidth  = 0.09
+)
+
+// maxAnnotations limits the number of arrows and of marks of an image.
+const maxAnnotations = 64
+
+// annotationColors are the colors arrows and marks can be given by name. Any
+// other color is given as #rrggbb.
+var annotationColors = map[string]color.Color{
+	"green":  color.NRGBA{21, 120, 27, 255},
+	"red":    color.NRGBA{204, 0, 0, 255},
+	"blue":   color.NRGBA{0, 48, 136, 255},
+	"yellow": color.NRGBA{230, 180, 0, 255},
+}
+
+const defaultAnnotationColor = "green"
+
+// parseAnnotation splits an arrow or mark, e.g. "e2e4:red", into its squares and
+// its color.
+func parseAnnotation(annotation string) (string, color.Color, error) {
+	squares, colorName := annotation, defaultAnnotationColor
+	if i := strings.Index(annotation, ":"); i >= 0 {
+		squares, colorName = annotation[:i], strings.ToLower(annotation[i+1:])
+	}
+
+	if c, ok := annotationColors[colorName]; ok {
+		return squares, c, nil
+	}
+	c, err := parseHexColor(colorName)
+	if err != nil {
+		return "", nil, err
+	}
+	return squares, c, nil
+}
+
+func formatAnnotationColor(c color.Color) string {
+	hex := colorToHex(c)
+	for name, named := range annotationColors {
+		if colorToHex(named) == hex {
+			return name
+		}
+	}
+	return hex
+}
+
+// parseArrows reads a comma separated list of arrows given as the squares they
+// join and an optional color, e.g. "e2e4,g1f3:blue".
+func parseArrows(list string) ([]BoardArrow, error) {
+	if list == "" {
+		return nil, nil
+	}
+
+	arrows := []BoardArrow{}
+	for _, annotation := range strings.Split(list, ",") {
+		squares, c, err := parseAnnotation(annotation)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid arrow %s", annotation)
+		}
+
+		if len(squares) != 4 {
+			return nil, errors.Errorf("invalid arrow %s", annotation)
+		}
+		from, okFrom := strToSquareMap[strings.ToLower(squares[:2])]
+		to, okTo := strToSquareMap[strings.ToLower(squares[2:])]
+		if !okFrom || !okTo {
+			return nil, errors.Errorf("invalid arrow %s", annotation)
+		}
+
+		arrows = append(arrows, BoardArrow{From: from, To: to, Color: c})
+	}
+
+	if len(arrows) > maxAnnotations {
+		return nil, errors.Errorf("too many arrows, the maximum is %d", maxAnnotations)
+	}
+	return arrows, nil
+}
+
+func formatArrows(arrows []BoardArrow) string {
+	list := make([]string, len(arrows))
+	for i, arrow := range arrows {
+		list[i] = arrow.From.String() + arrow.To.String() + ":" + formatAnnotationColor(arrow.Color)
+	}
+	return strings.Join(list, ",")
+}
+
+// parseMarks reads a comma separated list of marked squares with an optional
+// color, e.g. "e4,d5:red".
+func parseMarks(list string) ([]SquareMark, error) {
+	if list == "" {
+		return nil, nil
+	}
+
+	marks := []SquareMark{}
+	for _, annotation := range strings.Split(list, ",") {
+		square, c, err := parseAnnotation(annotation)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid mark %s", annotation)
+		}
+
+		sq, ok := strToSquareMap[strings.ToLower(square)]
+		if !ok {
+			return nil, errors.Errorf("invalid mark %s", annotation)
+		}
+
+		marks = append(marks, SquareMark{Square: sq, Color: c})
+	}
+
+	if len(marks) > maxAnnotations {
+		return nil, errors.Errorf("too many marks, the maximum is %d", maxAnnotations)
+	}
+	return marks, nil
+}
+
+func formatMarks(marks []SquareMark) string {
+	list := make([]string, len(marks))
+	for i, mark := range marks {
+		list[i] = mark.Square.String() + ":" + formatAnnotationColor(mark.Color)
+	}
+	return strings.Join(list, ",")
+}
+
+// GetBoardImage renders the board image, or returns it from the cache.
+func (gm *GameManager) GetBoardImage(img *BoardImage) (*renderedImage, error) {
+	theme := gm.getConfiguration().boardTheme(img.Theme)
+	// The resolved theme is part of the key, so changes to the themes are not hidden by the cache.
+	key := fmt.Sprintf("%+v %v", *img, *theme)
+	if cached, ok := gm.imageCache.get(key); ok {
+		return cached, nil
+	}
+
+	var rendered *renderedImage
+	switch img.Format {
+	case boardImagePNG:
+		board, err := renderBoardPNG(img, theme)
+		if err != nil {
+			return nil, err
+		}
+		buf := &bytes.Buffer{}
+		err = png.Encode(buf, board)
+		if err != nil {
+			return nil, err
+		}
+		rendered = newRenderedImage("image/png", buf.Bytes())
+	default:
+		board, err := renderBoardSVG(img, theme)
+		if err != nil {
+			return nil, err
+		}
+		rendered = newRenderedImage("image/svg+xml", board)
+	}
+
+	gm.imageCache.add(key, rendered)
+	return rendered, nil
+}
+
+// GetAnnotatedBoardLink returns the signed link to the current board of the game
+// with the given arrows and marks, e.g. "e2e4,g1f3:blue" and "d5:red", drawn over
+// it. The board is seen as userID likes it, and only users that can see the game
+// get a link.
+func (gm *GameManager) GetAnnotatedBoardLink(id, userID, arrows, marks string) (string, error) {
+	game, err := gm.getGame(id)
+	if err != nil {
+		return "", err
+	}
+
+	if !game.IsPlayer(userID) {
+		if _, appErr := gm.api.GetChannelMember(game.ChannelID, userID); appErr != nil {
+			return "", newUserError(messageCannotSee, nil)
+		}
+	} else if game.HidesBoard() {
+		return "", newUserError(messageBlindfoldBoard, nil)
+	}
+
+	img := gm.userBoardImage(game, userID, len(game.Moves()))
+	img.Arrows, err = parseArrows(arrows)
+	if err == nil {
+		img.Marks, err = parseMarks(marks)
+	}
+	if err != nil {
+		return "", newUserError(&i18n.Message{
+			ID:    "chess.error.annotations",
+			Other: "{{.Error}}, use arrows such as e2e4,g1f3:blue and marks such as e4,d5:red",
+		}, map[string]interface{}{"Error": err.Error()})
+	}
+
+	return gm.boardImageURL(img), nil
+}
+
+// squarePosition returns the column and the row, from the top left corner, where
+// the square is drawn on a board seen from the orientation side.
+func squarePosition(sq chess.Square, orientation chess.Color) (int, int) {
+	col, row := int(sq.File()), 7-int(sq.Rank())
+	if orientation == chess.Black {
+		return 7 - col, 7 - row
+	}
+	return col, row
+}
+
+// arrowPolygon returns the outline of an arrow between the centers of two squares,
+// on a board drawn with the given square size.
+func arrowPolygon(arrow BoardArrow, orientation chess.Color, squareSize float64) [][2]float64 {
+	fromCol, fromRow := squarePosition(arrow.From, orientation)
+	toCol, toRow := squarePosition(arrow.To, orientation)
+	x1, y1 := (float64(fromCol)+0.5)*squareSize, (float64(fromRow)+0.5)*squareSize
+	x2, y2 := (float64(toCol)+0.5)*squareSize, (float64(toRow)+0.5)*squareSize
+
+	length := math.Hypot(x2-x1, y2-y1)
+	if length == 0 {
+		return nil
+	}
+	// Unit vectors along the arrow and across it.
+	ux, uy := (x2-x1)/length, (y2-y1)/length
+	nx, ny := -uy, ux
+
+	shaft := squareSize * 0.1
+	head := squareSize * 0.3
+	headLength := math.Min(squareSize*0.45, length)
+	bx, by := x2-ux*headLength, y2-uy*headLength
+
+	return [][2]float64{
+		{x1 + nx*shaft, y1 + ny*shaft},
+		{bx + nx*shaft, by + ny*shaft},
+		{bx + nx*head, by + ny*head},
+		{x2, y2},
+		{bx - nx*head, by - ny*head},
+		{bx - nx*shaft, by - ny*shaft},
+		{x1 - nx*shaft, y1 - ny*shaft},
+	}
+}
+
+// markRing returns the outline of the circle marking a square, on a board drawn
+// with the given square size: the outer circle and the inner one, drawn in the
+// opposite direction to leave a hole.
+func markRing(mark SquareMark, orientation chess.Color, squareSize float64) [][][2]float64 {
+	col, row := squarePosition(mark.Square, orientation)
+	cx, cy := (float64(col)+0.5)*squareSize, (float64(row)+0.5)*squareSize
+	outer := squareSize * markRadius
+	inner := outer - squareSize*markWidth
+
+	const steps = 48
+	outerRing := make([][2]float64, steps)
+	innerRing := make([][2]float64, steps)
+	for i := 0; i < steps; i++ {
+		angle := 2 * math.Pi * float64(i) / steps
+		outerRing[i] = [2]float64{cx + outer*math.Cos(angle), cy + outer*math.Sin(angle)}
+		innerRing[steps-1-i] = [2]float64{cx + inner*math.Cos(angle), cy + inner*math.Sin(angle)}
+	}
+	return [][][2]float64{outerRing, innerRing}
+}
+
+// squareColor returns the color of the square in the theme.
+func squareColor(sq chess.Square, theme *BoardTheme) color.Color {
+	if (int(sq.File())+int(sq.Rank()))%2 == 0 {
+		return theme.Dark
+	}
+	return theme.Light
+}
+
+func colorToHex(c color.Color) string {
+	r, g, b, _ := c.RGBA()
+	return fmt.Sprintf("#%02x%02x%02x", uint8(r>>8), uint8(g>>8), uint8(b>>8))
+}
+
+var (
+	strToSquareMap = map[string]chess.Square{
+		"a1": chess.A1, "a2": chess.A2, "a3": chess.A3, "a4": chess.A4, "a5": chess.A5, "a6": chess.A6, "a7": chess.A7, "a8": chess.A8,
+		"b1": chess.B1, "b2": chess.B2, "b3": chess.B3, "b4": chess.B4, "b5": chess.B5, "b6": chess.B6, "b7": chess.B7, "b8": chess.B8,
+		"c1": chess.C1, "c2": chess.C2, "c3": chess.C3, "c4": chess.C4, "c5": chess.C5, "c6": chess.C6, "c7": chess.C7, "c8": chess.C8,
+		"d1": chess.D1, "d2": chess.D2, "d3": chess.D3, "d4": chess.D4, "d5": chess.D5, "d6": chess.D6, "d7": chess.D7, "d8": chess.D8,
+		"e1": chess.E1, "e2": chess.E2, "e3": chess.E3, "e4": chess.E4, "e5": chess.E5, "e6": chess.E6, "e7": chess.E7, "e8": chess.E8,
+		"f1": chess.F1, "f2": chess.F2, "f3": chess.F3, "f4": chess.F4, "f5": chess.F5, "f6": chess.F6, "f7": chess.F7, "f8": chess.F8,
+		"g1": chess.G1, "g2": chess.G2, "g3": chess.G3, "g4": chess.G4, "g5": chess.G5, "g6": chess.G6, "g7": chess.G7, "g8": chess.G8,
+		"h1": chess.H1, "h2": chess.H2, "h3": chess.H3, "h4": chess.H4, "h5": chess.H5, "h6": chess.H6, "h7": chess.H7, "h8": chess.H8,
+	}
+)
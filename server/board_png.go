@@ -0,0 +1,211 @@
+package main
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"math"
+
+	"github.com/notnil/chess"
+	"github.com/pkg/errors"
+	xdraw "golang.org/x/image/draw"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+	"golang.org/x/image/math/fixed"
+	"golang.org/x/image/vector"
+)
+
+const (
+	boardPNGDefaultSize = 360
+	boardPNGMinSize     = 120
+	boardPNGMaxSize     = 1024
+)
+
+// highlightAlpha and arrowAlpha match the opacities of the SVG board.
+const (
+	highlightAlpha = uint8(highlightOpacity * 255)
+	arrowAlpha     = uint8(arrowOpacity * 255)
+)
+
+// spritePieceOrder is the column of each piece type in the sprite sheets.
+var spritePieceOrder = map[chess.PieceType]int{
+	chess.King:   0,
+	chess.Queen:  1,
+	chess.Rook:   2,
+	chess.Bishop: 3,
+	chess.Knight: 4,
+	chess.Pawn:   5,
+}
+
+// renderBoardPNG rasterizes the board. The squares are sized so the board fits
+// the requested size, with the coordinates around it if asked for.
+func renderBoardPNG(img *BoardImage, theme *BoardTheme) (image.Image, error) {
+	fen, err := chess.FEN(img.FEN)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid board definition")
+	}
+	board := chess.NewGame(fen).Position().Board()
+
+	sprites, err := getPieceSprites(img.Pieces)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not load the pieces")
+	}
+
+	size := img.Size
+	if size == 0 {
+		size = boardPNGDefaultSize
+	}
+	if size < boardPNGMinSize {
+		size = boardPNGMinSize
+	}
+	if size > boardPNGMaxSize {
+		size = boardPNGMaxSize
+	}
+
+	margin := 0
+	squareSize := size / 8
+	if img.Coordinates {
+		// Keep the same proportion between the squares and the margin as the SVG board.
+		squareSize = size * boardSquareSize / (8*boardSquareSize + boardCoordinateMargin)
+		margin = size - 8*squareSize
+	}
+
+	rgba := image.NewRGBA(image.Rect(0, 0, 8*squareSize+margin, 8*squareSize+margin))
+	if margin > 0 {
+		draw.Draw(rgba, rgba.Bounds(), image.NewUniform(theme.Light), image.Point{}, draw.Src)
+	}
+
+	squareRect := func(sq chess.Square) image.Rectangle {
+		col, row := squarePosition(sq, img.Orientation)
+		min := image.Pt(margin+col*squareSize, row*squareSize)
+		return image.Rectangle{Min: min, Max: min.Add(image.Pt(squareSize, squareSize))}
+	}
+	fillSquare := func(sq chess.Square, c color.Color) {
+		draw.Draw(rgba, squareRect(sq), image.NewUniform(c), image.Point{}, draw.Over)
+	}
+
+	// The layers are the same as the ones of the SVG board.
+	for i := 0; i < 64; i++ {
+		sq := chess.Square(i)
+		draw.Draw(rgba, squareRect(sq), image.NewUniform(squareColor(sq, theme)), image.Point{}, draw.Src)
+	}
+
+	for _, s := range []string{img.From, img.To} {
+		if sq, ok := strToSquareMap[s]; ok {
+			fillSquare(sq, withAlpha(theme.Highlight, highlightAlpha))
+		}
+	}
+	if sq, ok := strToSquareMap[img.Capture]; ok {
+		fillSquare(sq, withAlpha(theme.Warning, highlightAlpha))
+	}
+	if sq, ok := strToSquareMap[img.Check]; ok {
+		drawCheckMarker(rgba, squareRect(sq), theme.Warning)
+	}
+
+	if margin > 0 {
+		drawPNGCoordinates(rgba, theme, img.Orientation, squareSize, margin)
+	}
+
+	for i := 0; i < 64; i++ {
+		sq := chess.Square(i)
+		piece := board.Piece(sq)
+		if piece == chess.NoPiece {
+			continue
+		}
+
+		row := 0
+		if piece.Color() == chess.Black {
+			row = 1
+		}
+		col := spritePieceOrder[piece.Type()]
+		sprite := image.Rect(col*pieceSpriteSize, row*pieceSpriteSize, (col+1)*pieceSpriteSize, (row+1)*pieceSpriteSize)
+		xdraw.CatmullRom.Scale(rgba, squareRect(sq), sprites, sprite, xdraw.Over, nil)
+	}
+
+	for _, mark := range img.Marks {
+		drawPolygons(rgba, markRing(mark, img.Orientation, float64(squareSize)), margin, withAlpha(mark.Color, arrowAlpha))
+	}
+	for _, arrow := range img.Arrows {
+		drawPolygons(rgba, [][][2]float64{arrowPolygon(arrow, img.Orientation, float64(squareSize))}, margin, withAlpha(arrow.Color, arrowAlpha))
+	}
+
+	return rgba, nil
+}
+
+// drawPNGCoordinates writes the rank labels on the left of the board and the file
+// labels below it.
+func drawPNGCoordinates(rgba *image.RGBA, theme *BoardTheme, orientation chess.Color, squareSize, margin int) {
+	face := basicfont.Face7x13
+	drawer := &font.Drawer{
+		Dst:  rgba,
+		Src:  image.NewUniform(theme.Dark),
+		Face: face,
+	}
+
+	label := func(s string, centerX, centerY int) {
+		width := drawer.MeasureString(s).Ceil()
+		drawer.Dot = fixed.P(centerX-width/2, centerY+face.Ascent/2)
+		drawer.DrawString(s)
+	}
+
+	for i := 0; i < 8; i++ {
+		rank := chess.Rank(7 - i)
+		file := chess.File(i)
+		if orientation == chess.Black {
+			rank = chess.Rank(i)
+			file = chess.File(7 - i)
+		}
+
+		center := i*squareSize + squareSize/2
+		label(rank.String(), margin/2, center)
+		label(file.String(), margin+center, 8*squareSize+margin/2)
+	}
+}
+
+func withAlpha(c color.Color, alpha uint8) color.Color {
+	n := color.NRGBAModel.Convert(c).(color.NRGBA)
+	n.A = alpha
+	return n
+}
+
+// drawCheckMarker fills the square with a glow fading from its center, like the
+// gradient of the SVG board. The glow is an alpha mask composited in one pass.
+func drawCheckMarker(rgba *image.RGBA, rect image.Rectangle, c color.Color) {
+	mask := image.NewAlpha(image.Rect(0, 0, rect.Dx(), rect.Dy()))
+	radius := float64(rect.Dx()) / 2
+	for y := 0; y < rect.Dy(); y++ {
+		for x := 0; x < rect.Dx(); x++ {
+			// Sample at the pixel center, so the glow is symmetric.
+			d := math.Hypot(float64(x)+0.5-radius, float64(y)+0.5-radius) / radius
+			if d >= 1 {
+				continue
+			}
+			// Fully opaque in the center, 0.6 halfway and transparent on the edge.
+			alpha := 1 - 0.8*d
+			if d > 0.5 {
+				alpha = 0.6 * (1 - d) / 0.5
+			}
+			mask.Pix[mask.PixOffset(x, y)] = uint8(alpha * 255)
+		}
+	}
+
+	draw.DrawMask(rgba, rect, image.NewUniform(c), image.Point{}, mask, image.Point{}, draw.Over)
+}
+
+// drawPolygons fills the outlines, given in board coordinates, as a single shape.
+func drawPolygons(rgba *image.RGBA, polygons [][][2]float64, margin int, c color.Color) {
+	bounds := rgba.Bounds()
+	rasterizer := vector.NewRasterizer(bounds.Dx(), bounds.Dy())
+	for _, polygon := range polygons {
+		if len(polygon) == 0 {
+			continue
+		}
+
+		rasterizer.MoveTo(float32(polygon[0][0])+float32(margin), float32(polygon[0][1]))
+		for _, p := range polygon[1:] {
+			rasterizer.LineTo(float32(p[0])+float32(margin), float32(p[1]))
+		}
+		rasterizer.ClosePath()
+	}
+	rasterizer.Draw(rgba, bounds, image.NewUniform(c), image.Point{})
+}
@@ -0,0 +1,152 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	svg "github.com/ajstarks/svgo"
+	"github.com/notnil/chess"
+	"github.com/pkg/errors"
+)
+
+const (
+	// highlightOpacity is the opacity of the colors blended over the squares.
+	highlightOpacity = 0.2
+	arrowOpacity     = 0.8
+
+	checkGradientID = "check"
+)
+
+// renderBoardSVG draws the board as an SVG image. The image is drawn in layers:
+// squares, highlights, coordinates, pieces, and the annotations: marks and arrows.
+func renderBoardSVG(img *BoardImage, theme *BoardTheme) ([]byte, error) {
+	fen, err := chess.FEN(img.FEN)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid board definition")
+	}
+	board := chess.NewGame(fen).Position().Board()
+
+	margin := 0
+	if img.Coordinates {
+		margin = boardCoordinateMargin
+	}
+	boardSize := 8 * boardSquareSize
+	size := boardSize + margin
+
+	buf := &bytes.Buffer{}
+	canvas := svg.New(buf)
+	// Some mobile clients do not scale the image without a viewBox.
+	canvas.Start(size, size, fmt.Sprintf(`viewBox="0 0 %d %d"`, size, size))
+
+	canvas.Def()
+	canvas.RadialGradient(checkGradientID, 50, 50, 50, 50, 50, []svg.Offcolor{
+		{Offset: 0, Color: colorToHex(theme.Warning), Opacity: 1},
+		{Offset: 50, Color: colorToHex(theme.Warning), Opacity: 0.6},
+		{Offset: 100, Color: colorToHex(theme.Warning), Opacity: 0},
+	})
+	canvas.DefEnd()
+
+	// Every layer is drawn in board coordinates, to the right of the rank labels.
+	canvas.Gtransform(fmt.Sprintf("translate(%d,0)", margin))
+
+	squareRect := func(sq chess.Square) (int, int) {
+		col, row := squarePosition(sq, img.Orientation)
+		return col * boardSquareSize, row * boardSquareSize
+	}
+	fillSquare := func(sq chess.Square, style string) {
+		x, y := squareRect(sq)
+		canvas.Rect(x, y, boardSquareSize, boardSquareSize, style)
+	}
+
+	canvas.Group(`id="squares"`)
+	for i := 0; i < 64; i++ {
+		sq := chess.Square(i)
+		fillSquare(sq, "fill:"+colorToHex(squareColor(sq, theme)))
+	}
+	canvas.Gend()
+
+	canvas.Group(`id="highlights"`)
+	for _, s := range []string{img.From, img.To} {
+		if sq, ok := strToSquareMap[s]; ok {
+			fillSquare(sq, fmt.Sprintf("fill:%s;fill-opacity:%g", colorToHex(theme.Highlight), highlightOpacity))
+		}
+	}
+	if sq, ok := strToSquareMap[img.Capture]; ok {
+		fillSquare(sq, fmt.Sprintf("fill:%s;fill-opacity:%g", colorToHex(theme.Warning), highlightOpacity))
+	}
+	if sq, ok := strToSquareMap[img.Check]; ok {
+		fillSquare(sq, fmt.Sprintf("fill:url(#%s)", checkGradientID))
+	}
+	canvas.Gend()
+
+	if img.Coordinates {
+		printCoordinates(canvas, theme, img.Orientation)
+	}
+
+	canvas.Group(`id="pieces"`)
+	for i := 0; i < 64; i++ {
+		sq := chess.Square(i)
+		piece := board.Piece(sq)
+		if piece == chess.NoPiece {
+			continue
+		}
+
+		x, y := squareRect(sq)
+		fmt.Fprintf(canvas.Writer, `<svg x="%d" y="%d" width="%d" height="%d" viewBox="0 0 45 45">%s</svg>`+"\n",
+			x, y, boardSquareSize, boardSquareSize, pieceSVG(img.Pieces, piece))
+	}
+	canvas.Gend()
+
+	canvas.Group(`id="annotations"`)
+	for _, mark := range img.Marks {
+		col, row := squarePosition(mark.Square, img.Orientation)
+		fmt.Fprintf(canvas.Writer, `<circle cx="%.1f" cy="%.1f" r="%.1f" style="fill:none;stroke:%s;stroke-width:%.1f;stroke-opacity:%g" />`+"\n",
+			(float64(col)+0.5)*boardSquareSize, (float64(row)+0.5)*boardSquareSize,
+			(markRadius-markWidth/2)*boardSquareSize, colorToHex(mark.Color), markWidth*boardSquareSize, arrowOpacity)
+	}
+	for _, arrow := range img.Arrows {
+		polygon := arrowPolygon(arrow, img.Orientation, boardSquareSize)
+		if polygon == nil {
+			continue
+		}
+
+		points := make([]string, len(polygon))
+		for i, p := range polygon {
+			points[i] = fmt.Sprintf("%.1f,%.1f", p[0], p[1])
+		}
+		fmt.Fprintf(canvas.Writer, `<polygon points="%s" style="fill:%s;fill-opacity:%g" />`+"\n",
+			strings.Join(points, " "), colorToHex(arrow.Color), arrowOpacity)
+	}
+	canvas.Gend()
+
+	canvas.Gend()
+	canvas.End()
+
+	return buf.Bytes(), nil
+}
+
+// printCoordinates draws the rank labels on the left of the board and the file
+// labels below it. The board starts at x=0, so the rank labels are drawn at
+// negative x.
+func printCoordinates(canvas *svg.SVG, theme *BoardTheme, orientation chess.Color) {
+	size := 8 * boardSquareSize
+	canvas.Group(`id="coordinates"`)
+	canvas.Rect(-boardCoordinateMargin, 0, boardCoordinateMargin, size+boardCoordinateMargin, "fill:"+colorToHex(theme.Light))
+	canvas.Rect(0, size, size, boardCoordinateMargin, "fill:"+colorToHex(theme.Light))
+
+	style := "text-anchor:middle;font-size:13px;font-family:sans-serif;fill:" + colorToHex(theme.Dark)
+	for i := 0; i < 8; i++ {
+		rank := chess.Rank(7 - i)
+		file := chess.File(i)
+		if orientation == chess.Black {
+			rank = chess.Rank(i)
+			file = chess.File(7 - i)
+		}
+
+		center := i*boardSquareSize + boardSquareSize/2
+		canvas.Text(-boardCoordinateMargin/2, center+5, rank.String(), style)
+		canvas.Text(center, size+boardCoordinateMargin-6, file.String(), style)
+	}
+	canvas.Gend()
+}
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/mattermost/mattermost-plugin-api/i18n"
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/notnil/chess"
+)
+
+// browsePlyContext is the key of the post action context holding the position to
+// show, as the number of moves played to reach it.
+const browsePlyContext = "ply"
+
+// browseActions returns the buttons to go to the first, previous, next and last
+// positions of a finished game, from the position after ply moves.
+func (gm *GameManager) browseActions(game *Game, ply int) []*model.PostAction {
+	baseURL := gm.api.GetConfig().ServiceSettings.SiteURL
+	browseURL := fmt.Sprintf("%s/plugins/%s/browse/%s", *baseURL, manifest.Id, game.ID)
+	last := len(game.Moves())
+
+	targets := []struct {
+		name string
+		ply  int
+	}{
+		{"⏮", 0},
+		{"◀", ply - 1},
+		{"▶", ply + 1},
+		{"⏭", last},
+	}
+
+	actions := []*model.PostAction{}
+	for _, target := range targets {
+		if target.ply < 0 {
+			target.ply = 0
+		}
+		if target.ply > last {
+			target.ply = last
+		}
+
+		actions = append(actions, &model.PostAction{
+			Type: "button",
+			Name: target.name,
+			Integration: &model.PostActionIntegration{
+				URL:     browseURL,
+				Context: map[string]interface{}{browsePlyContext: target.ply},
+			},
+		})
+	}
+	return actions
+}
+
+// BrowseGame returns the post showing the position of the finished game after ply
+// moves to the user. The post is meant to be ephemeral, so each user browses the
+// game on their own.
+func (gm *GameManager) BrowseGame(id, userID string, ply int) (*model.Post, error) {
+	game, err := gm.getGame(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !game.IsPlayer(userID) {
+		if _, appErr := gm.api.GetChannelMember(game.ChannelID, userID); appErr != nil {
+			return nil, newUserError(messageCannotSee, nil)
+		}
+	}
+	if !game.IsOver() {
+		return nil, newUserError(&i18n.Message{
+			ID:    "chess.error.browse_unfinished",
+			Other: "only finished games can be browsed",
+		}, nil)
+	}
+
+	moves := game.Moves()
+	if ply < 0 || ply > len(moves) {
+		return nil, newUserError(&i18n.Message{
+			ID:    "chess.error.invalid_position",
+			Other: "invalid position",
+		}, nil)
+	}
+
+	whiteUser, blackUser := gm.getPlayers(game)
+	l := gm.userLocalizer(userID)
+	attachment := &model.SlackAttachment{
+		Title:   gm.localize(l, messageGameTitle, nil),
+		Text:    gm.localize(l, messagePlayers, map[string]interface{}{"White": whiteUser.Username, "Black": blackUser.Username}) + "\n",
+		Actions: gm.browseActions(game, ply),
+	}
+	if ply == 0 {
+		attachment.Text += gm.localize(l, &i18n.Message{
+			ID:    "chess.browse.start",
+			Other: "Starting position",
+		}, nil)
+	} else {
+		move := moves[ply-1]
+		san := gm.userNotation(userID).format(chess.AlgebraicNotation{}.Encode(game.Positions()[ply-1], move))
+		number := fmt.Sprintf("%d.", (ply-1)/2+1)
+		if ply%2 == 0 {
+			number = fmt.Sprintf("%d...", ply/2)
+		}
+		attachment.Text += gm.localize(l, &i18n.Message{
+			ID:    "chess.browse.move",
+			Other: "Move {{.Number}} {{.SAN}}",
+		}, map[string]interface{}{"Number": number, "SAN": san})
+	}
+	if game.Display == boardDisplayText {
+		attachment.Text += "\n" + gm.textBoard(l, game.Positions()[ply].Board(), viewerOrientation(game, userID), gm.userNotation(userID))
+	} else {
+		attachment.ImageURL = gm.userBoardLink(game, userID, ply)
+	}
+	attachment.Footer = gm.localize(l, &i18n.Message{
+		ID:    "chess.browse.position",
+		Other: "Position {{.Ply}} of {{.Plies}}",
+	}, map[string]interface{}{"Ply": ply, "Plies": len(moves)})
+
+	post := &model.Post{
+		ChannelId: game.ChannelID,
+		UserId:    gm.botID,
+	}
+	model.ParseSlackAttachment(post, []*model.SlackAttachment{attachment})
+	return post, nil
+}
@@ -1,21 +1,76 @@
 package main
 
 import (
-	"errors"
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/mattermost/mattermost-plugin-api/i18n"
 	"github.com/mattermost/mattermost-server/v5/model"
 	"github.com/mattermost/mattermost-server/v5/plugin"
+	"github.com/notnil/chess"
 )
 
-func getHelp() string {
-	return `Available Commands:
+const historyPageSize = 10
+
+var (
+	messageInvalidUser = &i18n.Message{
+		ID:    "chess.command.invalid_user",
+		Other: "Please, provide a valid user.",
+	}
+	messageUnknownUser = &i18n.Message{
+		ID:    "chess.command.unknown_user",
+		Other: "unknown",
+	}
+	messageResultBy = &i18n.Message{
+		ID:    "chess.result.by",
+		Other: "{{.Result}} by {{.Method}}",
+	}
+	messageView = &i18n.Message{
+		ID:    "chess.command.view",
+		Other: "[view]({{.URL}})",
+	}
+)
+
+// getHelp returns the usage of the commands in the language of the localizer.
+func (p *Plugin) getHelp(l *i18n.Localizer) string {
+	return p.gameManager.localize(l, &i18n.Message{
+		ID: "chess.command.help",
+		Other: `Available Commands:
 
 challenge @user
 	Challenge a user for a game of chess
-`
+stats [@user]
+	Show your chess stats, or the stats of another user
+vs @user
+	Show your head-to-head record against a user
+history [@user] [page]
+	List past games, yours or of another user
+games
+	List your games in progress
+export [game]
+	Show the PGN of the game in this channel, or of the given game ID
+board [game]
+	Show the board of the game in this channel, or of the given game ID, as text
+annotate [game] arrows=e2e4,g1f3:blue marks=e4,d5:red
+	Draw arrows and circled squares on the board of the game in this channel, or of the given game ID, with a link to share it
+display image|text|blindfold
+	Show the board of the game in this channel as an image, as text, or not at all until the game is over
+log on|off
+	Reply to the game post in this channel with every move, or stop it
+replay [game]
+	Share an animated replay of the finished game in this channel, or of the given game ID
+settings [setting value]
+	Show or change your settings:
+	notifications dm|thread|off: how to be told it is your turn
+	coordinates on|off: show the rank and file labels on your board
+	theme name|default: the colors of your board
+	pieces name|default: the piece set of your board
+	notation letters|figurine: write the pieces of the moves with the letters of your language, or as figurines
+`,
+	}, nil)
 }
 
 func getCommand() *model.Command {
@@ -24,7 +79,7 @@ func getCommand() *model.Command {
 		DisplayName:      "Chess Bot",
 		Description:      "Play chess",
 		AutoComplete:     true,
-		AutoCompleteDesc: "Available commands: challenge",
+		AutoCompleteDesc: "Available commands: challenge, stats, vs, history, games, board, annotate, display, export, log, replay, settings",
 		AutoCompleteHint: "[command]",
 		AutocompleteData: getAutocompleteData(),
 	}
@@ -47,9 +102,10 @@ func (p *Plugin) ExecuteCommand(c *plugin.Context, args *model.CommandArgs) (*mo
 	lengthOfArgs := len(stringArgs)
 	restOfArgs := []string{}
 
+	l := p.gameManager.userLocalizer(args.UserId)
 	var handler func([]string, *model.CommandArgs) (bool, *model.CommandResponse, error)
 	if lengthOfArgs == 1 {
-		p.postCommandResponse(args, getHelp())
+		p.postCommandResponse(args, p.getHelp(l))
 		return &model.CommandResponse{}, nil
 	}
 	command := stringArgs[1]
@@ -59,17 +115,45 @@ func (p *Plugin) ExecuteCommand(c *plugin.Context, args *model.CommandArgs) (*mo
 	switch command {
 	case "challenge":
 		handler = p.runChallengeCommand
+	case "stats":
+		handler = p.runStatsCommand
+	case "vs":
+		handler = p.runVsCommand
+	case "history":
+		handler = p.runHistoryCommand
+	case "games":
+		handler = p.runGamesCommand
+	case "export":
+		handler = p.runExportCommand
+	case "board":
+		handler = p.runBoardCommand
+	case "annotate":
+		handler = p.runAnnotateCommand
+	case "display":
+		handler = p.runDisplayCommand
+	case "log":
+		handler = p.runLogCommand
+	case "replay":
+		handler = p.runReplayCommand
+	case "settings":
+		handler = p.runSettingsCommand
 	default:
-		p.postCommandResponse(args, getHelp())
+		p.postCommandResponse(args, p.getHelp(l))
 		return &model.CommandResponse{}, nil
 	}
 	isUserError, resp, err := handler(restOfArgs, args)
 	if err != nil {
 		if isUserError {
-			p.postCommandResponse(args, fmt.Sprintf("__Error: %s.__\n\nRun `/todo help` for usage instructions.", err.Error()))
+			p.postCommandResponse(args, p.gameManager.localize(l, &i18n.Message{
+				ID:    "chess.command.error",
+				Other: "__Error: {{.Error}}.__\n\nRun `/chess help` for usage instructions.",
+			}, map[string]interface{}{"Error": p.gameManager.localizeError(l, err)}))
 		} else {
 			p.API.LogError(err.Error())
-			p.postCommandResponse(args, "An unknown error occurred. Please talk to your system administrator for help.")
+			p.postCommandResponse(args, p.gameManager.localize(l, &i18n.Message{
+				ID:    "chess.command.unknown_error",
+				Other: "An unknown error occurred. Please talk to your system administrator for help.",
+			}, nil))
 		}
 	}
 
@@ -81,41 +165,50 @@ func (p *Plugin) ExecuteCommand(c *plugin.Context, args *model.CommandArgs) (*mo
 }
 
 func (p *Plugin) runChallengeCommand(args []string, extra *model.CommandArgs) (bool, *model.CommandResponse, error) {
+	l := p.gameManager.userLocalizer(extra.UserId)
 	var receiver *model.User
 	if len(args) < 1 {
 		var err error
 		receiver, err = p.getOtherUserFromChannel(extra)
 		if err != nil {
-			p.postCommandResponse(extra, "Error: "+err.Error())
+			p.postCommandResponse(extra, p.gameManager.localize(l, &i18n.Message{
+				ID:    "chess.command.challenge_error",
+				Other: "Error: {{.Error}}",
+			}, map[string]interface{}{"Error": p.gameManager.localizeError(l, err)}))
 			return false, nil, nil
 		}
 	} else {
-		userName := args[0]
-		if args[0][0] == '@' {
-			userName = args[0][1:]
-		}
 		var appErr *model.AppError
-		receiver, appErr = p.API.GetUserByUsername(userName)
+		receiver, appErr = p.getUserFromMention(args[0])
 		if appErr != nil {
-			p.postCommandResponse(extra, "Please, provide a valid user.\n"+getHelp())
+			p.postCommandResponse(extra, p.gameManager.localize(l, messageInvalidUser, nil)+"\n"+p.getHelp(l))
 			return false, nil, nil
 		}
 	}
 
 	if receiver.Id == extra.UserId {
-		p.postCommandResponse(extra, "You cannot challenge yourself.\n"+getHelp())
+		p.postCommandResponse(extra, p.gameManager.localize(l, &i18n.Message{
+			ID:    "chess.command.challenge_self",
+			Other: "You cannot challenge yourself.",
+		}, nil)+"\n"+p.getHelp(l))
 		return false, nil, nil
 	}
 
 	err := p.gameManager.CreateGame(extra.UserId, receiver.Id)
 	if err != nil {
-		p.postCommandResponse(extra, "Could not create the game. Error: "+err.Error())
+		p.postCommandResponse(extra, p.gameManager.localize(l, &i18n.Message{
+			ID:    "chess.command.create_error",
+			Other: "Could not create the game. Error: {{.Error}}",
+		}, map[string]interface{}{"Error": p.gameManager.localizeError(l, err)}))
 		return false, nil, nil
 	}
 
 	t, appErr := p.API.GetTeam(extra.TeamId)
 	if appErr != nil {
-		p.postCommandResponse(extra, "Game created, but could not redirect you to the DM. Error: "+appErr.Error())
+		p.postCommandResponse(extra, p.gameManager.localize(l, &i18n.Message{
+			ID:    "chess.command.redirect_error",
+			Other: "Game created, but could not redirect you to the DM. Error: {{.Error}}",
+		}, map[string]interface{}{"Error": appErr.Error()}))
 		return false, nil, nil
 	}
 
@@ -125,6 +218,450 @@ func (p *Plugin) runChallengeCommand(args []string, extra *model.CommandArgs) (b
 	}, nil
 }
 
+func (p *Plugin) runStatsCommand(args []string, extra *model.CommandArgs) (bool, *model.CommandResponse, error) {
+	l := p.gameManager.userLocalizer(extra.UserId)
+	var user *model.User
+	var appErr *model.AppError
+	if len(args) < 1 {
+		user, appErr = p.API.GetUser(extra.UserId)
+	} else {
+		user, appErr = p.getUserFromMention(args[0])
+	}
+	if appErr != nil {
+		p.postCommandResponse(extra, p.gameManager.localize(l, messageInvalidUser, nil)+"\n"+p.getHelp(l))
+		return false, nil, nil
+	}
+
+	games, err := p.gameManager.GetArchivedGames(user.Id)
+	if err != nil {
+		return false, nil, err
+	}
+
+	p.postCommandResponse(extra, p.gameManager.formatStats(l, user.Username, computeStats(user.Id, games)))
+	return false, nil, nil
+}
+
+func (p *Plugin) runVsCommand(args []string, extra *model.CommandArgs) (bool, *model.CommandResponse, error) {
+	l := p.gameManager.userLocalizer(extra.UserId)
+	if len(args) < 1 {
+		p.postCommandResponse(extra, p.gameManager.localize(l, &i18n.Message{
+			ID:    "chess.command.missing_user",
+			Other: "Please, provide a user.",
+		}, nil)+"\n"+p.getHelp(l))
+		return false, nil, nil
+	}
+
+	opponent, appErr := p.getUserFromMention(args[0])
+	if appErr != nil {
+		p.postCommandResponse(extra, p.gameManager.localize(l, messageInvalidUser, nil)+"\n"+p.getHelp(l))
+		return false, nil, nil
+	}
+
+	user, appErr := p.API.GetUser(extra.UserId)
+	if appErr != nil {
+		return false, nil, appErr
+	}
+
+	games, err := p.gameManager.GetArchivedGames(user.Id)
+	if err != nil {
+		return false, nil, err
+	}
+
+	against := []*ArchivedGame{}
+	for _, g := range games {
+		if g.Opponent(user.Id) == opponent.Id {
+			against = append(against, g)
+		}
+	}
+
+	p.postCommandResponse(extra, p.gameManager.formatHeadToHead(l, user.Username, opponent.Username, computeStats(user.Id, against)))
+	return false, nil, nil
+}
+
+func (p *Plugin) runHistoryCommand(args []string, extra *model.CommandArgs) (bool, *model.CommandResponse, error) {
+	l := p.gameManager.userLocalizer(extra.UserId)
+	userID := extra.UserId
+	page := 0
+	for _, arg := range args {
+		if n, err := strconv.Atoi(arg); err == nil {
+			if n < 1 {
+				return true, nil, newUserError(&i18n.Message{
+					ID:    "chess.error.page",
+					Other: "page must be a positive number",
+				}, nil)
+			}
+			page = n - 1
+			continue
+		}
+
+		user, appErr := p.getUserFromMention(arg)
+		if appErr != nil {
+			p.postCommandResponse(extra, p.gameManager.localize(l, messageInvalidUser, nil)+"\n"+p.getHelp(l))
+			return false, nil, nil
+		}
+		userID = user.Id
+	}
+
+	games, more, err := p.gameManager.GetArchivedGamesPage(userID, page, historyPageSize)
+	if err != nil {
+		return false, nil, err
+	}
+
+	if len(games) == 0 {
+		p.postCommandResponse(extra, p.gameManager.localize(l, &i18n.Message{
+			ID:    "chess.command.no_games",
+			Other: "No games found.",
+		}, nil))
+		return false, nil, nil
+	}
+
+	teamName := ""
+	if t, appErr := p.API.GetTeam(extra.TeamId); appErr == nil {
+		teamName = t.Name
+	}
+
+	text := p.gameManager.localize(l, &i18n.Message{
+		ID:    "chess.command.history_title",
+		Other: "#### Game history (page {{.Page}})",
+	}, map[string]interface{}{"Page": page + 1}) + "\n\n"
+	for _, g := range games {
+		text += "- " + p.formatHistoryEntry(l, userID, g, extra.SiteURL, teamName) + "\n"
+	}
+	if more {
+		nextArgs := append(mentionArgs(args), strconv.Itoa(page+2))
+		text += "\n" + p.gameManager.localize(l, &i18n.Message{
+			ID:    "chess.command.history_more",
+			Other: "Run `/chess history {{.Args}}` to see older games.",
+		}, map[string]interface{}{"Args": strings.Join(nextArgs, " ")})
+	}
+
+	p.postCommandResponse(extra, text)
+	return false, nil, nil
+}
+
+func (p *Plugin) formatHistoryEntry(l *i18n.Localizer, userID string, g *ArchivedGame, siteURL, teamName string) string {
+	gm := p.gameManager
+	opponentName := gm.localize(l, messageUnknownUser, nil)
+	if opponent, appErr := p.API.GetUser(g.Opponent(userID)); appErr == nil {
+		opponentName = "@" + opponent.Username
+	}
+
+	result := gm.localize(l, &i18n.Message{
+		ID:    "chess.result.draw",
+		Other: "Draw",
+	}, nil)
+	switch {
+	case g.Won(userID):
+		result = gm.localize(l, &i18n.Message{
+			ID:    "chess.result.won",
+			Other: "Won",
+		}, nil)
+	case g.Lost(userID):
+		result = gm.localize(l, &i18n.Message{
+			ID:    "chess.result.lost",
+			Other: "Lost",
+		}, nil)
+	}
+	switch {
+	case g.Termination == terminationAborted:
+		result = gm.localize(l, &i18n.Message{
+			ID:    "chess.result.aborted",
+			Other: "Aborted",
+		}, nil)
+	case g.Termination == terminationAbandoned:
+		result = gm.localize(l, messageResultBy, map[string]interface{}{"Result": result, "Method": gm.localize(l, messageAbandonment, nil)})
+	case g.Method != chess.NoMethod:
+		result = gm.localize(l, messageResultBy, map[string]interface{}{"Result": result, "Method": gm.localizeMethod(l, g.Method)})
+	}
+
+	date := time.Unix(0, g.EndedAt*int64(time.Millisecond)).Format("2006-01-02")
+	entry := gm.localizePlural(l, &i18n.Message{
+		ID:    "chess.command.history_entry",
+		One:   "{{.Date}} vs {{.Opponent}} as {{.Color}}, {{.Result}} ({{.Count}} move)",
+		Other: "{{.Date}} vs {{.Opponent}} as {{.Color}}, {{.Result}} ({{.Count}} moves)",
+	}, (g.Plies+1)/2, map[string]interface{}{
+		"Date":     date,
+		"Opponent": opponentName,
+		"Color":    gm.localizeColor(l, g.Color(userID)),
+		"Result":   result,
+	})
+	if g.PostID != "" && teamName != "" {
+		entry += " " + gm.localize(l, messageView, map[string]interface{}{"URL": fmt.Sprintf("%s/%s/pl/%s", siteURL, teamName, g.PostID)})
+	}
+
+	return entry
+}
+
+func (p *Plugin) runGamesCommand(args []string, extra *model.CommandArgs) (bool, *model.CommandResponse, error) {
+	gm := p.gameManager
+	l := gm.userLocalizer(extra.UserId)
+	games, err := p.gameManager.GetActiveGames(extra.UserId)
+	if err != nil {
+		return false, nil, err
+	}
+
+	if len(games) == 0 {
+		p.postCommandResponse(extra, gm.localize(l, &i18n.Message{
+			ID:    "chess.command.no_active_games",
+			Other: "You have no games in progress. Start one with `/chess challenge @someone`.",
+		}, nil))
+		return false, nil, nil
+	}
+
+	teamName := ""
+	if t, appErr := p.API.GetTeam(extra.TeamId); appErr == nil {
+		teamName = t.Name
+	}
+
+	text := gm.localize(l, &i18n.Message{
+		ID:    "chess.command.games_title",
+		Other: "#### Your games in progress",
+	}, nil) + "\n\n"
+	for _, g := range games {
+		opponentName := gm.localize(l, messageUnknownUser, nil)
+		if opponent, appErr := p.API.GetUser(g.OpponentID); appErr == nil {
+			opponentName = "@" + opponent.Username
+		}
+
+		turn := gm.localize(l, &i18n.Message{
+			ID:    "chess.command.their_turn",
+			Other: "their turn",
+		}, nil)
+		if g.YourTurn {
+			turn = gm.localize(l, &i18n.Message{
+				ID:    "chess.command.your_turn",
+				Other: "**your turn**",
+			}, nil)
+		}
+
+		text += "- " + gm.localize(l, &i18n.Message{
+			ID:    "chess.command.games_entry",
+			Other: "vs {{.Opponent}} as {{.Color}}, move {{.Move}}, {{.Turn}}",
+		}, map[string]interface{}{
+			"Opponent": opponentName,
+			"Color":    gm.localizeColor(l, g.Color),
+			"Move":     g.MoveNumber,
+			"Turn":     turn,
+		})
+		if g.PostID != "" && teamName != "" {
+			text += " " + gm.localize(l, messageView, map[string]interface{}{"URL": fmt.Sprintf("%s/%s/pl/%s", extra.SiteURL, teamName, g.PostID)})
+		}
+		text += "\n"
+	}
+
+	p.postCommandResponse(extra, text)
+	return false, nil, nil
+}
+
+func (p *Plugin) runExportCommand(args []string, extra *model.CommandArgs) (bool, *model.CommandResponse, error) {
+	var gameID string
+	if len(args) > 0 {
+		gameID = args[0]
+	} else {
+		var err error
+		gameID, err = p.gameManager.GetChannelGameID(extra.ChannelId)
+		if err != nil {
+			return true, nil, err
+		}
+	}
+
+	pgn, err := p.gameManager.ExportGame(gameID, extra.UserId)
+	if err != nil {
+		return true, nil, err
+	}
+
+	l := p.gameManager.userLocalizer(extra.UserId)
+	download := p.gameManager.localize(l, &i18n.Message{
+		ID:    "chess.command.download_pgn",
+		Other: "[Download PGN]({{.URL}})",
+	}, map[string]interface{}{"URL": fmt.Sprintf("%s/plugins/%s/pgn/%s", extra.SiteURL, manifest.Id, gameID)})
+	p.postCommandResponse(extra, fmt.Sprintf("```\n%s```\n%s", pgn, download))
+	return false, nil, nil
+}
+
+func (p *Plugin) runBoardCommand(args []string, extra *model.CommandArgs) (bool, *model.CommandResponse, error) {
+	var gameID string
+	if len(args) > 0 {
+		gameID = args[0]
+	} else {
+		var err error
+		gameID, err = p.gameManager.GetChannelGameID(extra.ChannelId)
+		if err != nil {
+			return true, nil, err
+		}
+	}
+
+	text, err := p.gameManager.GetTextBoard(gameID, extra.UserId)
+	if err != nil {
+		return true, nil, err
+	}
+
+	p.postCommandResponse(extra, text)
+	return false, nil, nil
+}
+
+func (p *Plugin) runAnnotateCommand(args []string, extra *model.CommandArgs) (bool, *model.CommandResponse, error) {
+	var gameID, arrows, marks string
+	for _, arg := range args {
+		switch {
+		case strings.HasPrefix(arg, "arrows="):
+			arrows = strings.TrimPrefix(arg, "arrows=")
+		case strings.HasPrefix(arg, "marks="):
+			marks = strings.TrimPrefix(arg, "marks=")
+		default:
+			gameID = arg
+		}
+	}
+	if arrows == "" && marks == "" {
+		return true, nil, newUserError(&i18n.Message{
+			ID:    "chess.error.annotate_usage",
+			Other: "provide arrows=e2e4,g1f3:blue, marks=e4,d5:red or both",
+		}, nil)
+	}
+
+	if gameID == "" {
+		var err error
+		gameID, err = p.gameManager.GetChannelGameID(extra.ChannelId)
+		if err != nil {
+			return true, nil, err
+		}
+	}
+
+	link, err := p.gameManager.GetAnnotatedBoardLink(gameID, extra.UserId, arrows, marks)
+	if err != nil {
+		return true, nil, err
+	}
+
+	l := p.gameManager.userLocalizer(extra.UserId)
+	p.postCommandResponse(extra, p.gameManager.localize(l, &i18n.Message{
+		ID:    "chess.command.annotated_board",
+		Other: "![board]({{.URL}})\n[Link to this board]({{.URL}})",
+	}, map[string]interface{}{"URL": link}))
+	return false, nil, nil
+}
+
+func (p *Plugin) runDisplayCommand(args []string, extra *model.CommandArgs) (bool, *model.CommandResponse, error) {
+	if len(args) != 1 {
+		return true, nil, newUserError(&i18n.Message{
+			ID:    "chess.error.display_usage",
+			Other: "use image, text or blindfold",
+		}, nil)
+	}
+
+	gameID, err := p.gameManager.GetChannelGameID(extra.ChannelId)
+	if err != nil {
+		return true, nil, err
+	}
+
+	post, err := p.gameManager.SetDisplay(gameID, extra.UserId, args[0])
+	if err != nil {
+		return true, nil, err
+	}
+
+	if _, appErr := p.API.UpdatePost(post); appErr != nil {
+		return false, nil, appErr
+	}
+	return false, nil, nil
+}
+
+func (p *Plugin) runLogCommand(args []string, extra *model.CommandArgs) (bool, *model.CommandResponse, error) {
+	if len(args) != 1 || (args[0] != "on" && args[0] != "off") {
+		return true, nil, newUserError(&i18n.Message{
+			ID:    "chess.error.log_usage",
+			Other: "use on or off",
+		}, nil)
+	}
+
+	gameID, err := p.gameManager.GetChannelGameID(extra.ChannelId)
+	if err != nil {
+		return true, nil, err
+	}
+
+	on := args[0] == "on"
+	if err = p.gameManager.SetMoveLog(gameID, extra.UserId, on); err != nil {
+		return true, nil, err
+	}
+
+	l := p.gameManager.userLocalizer(extra.UserId)
+	if on {
+		p.postCommandResponse(extra, p.gameManager.localize(l, &i18n.Message{
+			ID:    "chess.command.log_on",
+			Other: "The moves of this game will be posted in the game thread.",
+		}, nil))
+	} else {
+		p.postCommandResponse(extra, p.gameManager.localize(l, &i18n.Message{
+			ID:    "chess.command.log_off",
+			Other: "The moves of this game will no longer be posted in the game thread.",
+		}, nil))
+	}
+	return false, nil, nil
+}
+
+func (p *Plugin) runReplayCommand(args []string, extra *model.CommandArgs) (bool, *model.CommandResponse, error) {
+	var gameID string
+	if len(args) > 0 {
+		gameID = args[0]
+	} else {
+		var err error
+		gameID, err = p.gameManager.GetChannelGameID(extra.ChannelId)
+		if err != nil {
+			return true, nil, err
+		}
+	}
+
+	err := p.gameManager.ShareReplay(gameID, extra.UserId, extra.ChannelId, extra.RootId)
+	if err != nil {
+		return true, nil, err
+	}
+
+	l := p.gameManager.userLocalizer(extra.UserId)
+	p.postCommandResponse(extra, p.gameManager.localize(l, &i18n.Message{
+		ID:    "chess.command.replay_started",
+		Other: "Drawing the replay, it will be posted here in a moment.",
+	}, nil))
+	return false, nil, nil
+}
+
+func (p *Plugin) runSettingsCommand(args []string, extra *model.CommandArgs) (bool, *model.CommandResponse, error) {
+	l := p.gameManager.userLocalizer(extra.UserId)
+	if len(args) == 0 {
+		p.postCommandResponse(extra, p.gameManager.formatUserPreferences(l, p.gameManager.GetUserPreferences(extra.UserId)))
+		return false, nil, nil
+	}
+
+	if len(args) != 2 {
+		return true, nil, newUserError(&i18n.Message{
+			ID:    "chess.error.settings_usage",
+			Other: "provide a setting and its value",
+		}, nil)
+	}
+
+	err := p.gameManager.SetUserPreference(extra.UserId, args[0], args[1])
+	if err != nil {
+		return true, nil, err
+	}
+
+	p.postCommandResponse(extra, p.gameManager.localize(l, &i18n.Message{
+		ID:    "chess.command.setting_updated",
+		Other: "Setting {{.Setting}} updated to {{.Value}}.",
+	}, map[string]interface{}{"Setting": args[0], "Value": args[1]}))
+	return false, nil, nil
+}
+
+func mentionArgs(args []string) []string {
+	mentions := []string{}
+	for _, arg := range args {
+		if _, err := strconv.Atoi(arg); err != nil {
+			mentions = append(mentions, arg)
+		}
+	}
+	return mentions
+}
+
+func (p *Plugin) getUserFromMention(mention string) (*model.User, *model.AppError) {
+	return p.API.GetUserByUsername(strings.TrimPrefix(mention, "@"))
+}
+
 func (p *Plugin) getOtherUserFromChannel(extra *model.CommandArgs) (*model.User, error) {
 	c, appErr := p.API.GetChannel(extra.ChannelId)
 	if appErr != nil {
@@ -132,7 +669,10 @@ func (p *Plugin) getOtherUserFromChannel(extra *model.CommandArgs) (*model.User,
 	}
 
 	if c.Type != model.CHANNEL_DIRECT {
-		return nil, errors.New("you can only start a chess challenge on a direct message")
+		return nil, newUserError(&i18n.Message{
+			ID:    "chess.error.challenge_channel",
+			Other: "you can only start a chess challenge on a direct message",
+		}, nil)
 	}
 
 	otherID := c.GetOtherUserIdForDM(extra.UserId)
@@ -146,11 +686,90 @@ func (p *Plugin) getOtherUserFromChannel(extra *model.CommandArgs) (*model.User,
 }
 
 func getAutocompleteData() *model.AutocompleteData {
-	chess := model.NewAutocompleteData("chess", "[command]", "Available commands: challenge")
+	chess := model.NewAutocompleteData("chess", "[command]", "Available commands: challenge, stats, vs, history, games, board, annotate, display, export, log, replay, settings")
 
 	challenge := model.NewAutocompleteData("challenge", "[user]", "Challenges a user")
 	challenge.AddTextArgument("Whom to challenge", "[@someone]", "")
 	chess.AddCommand(challenge)
 
+	stats := model.NewAutocompleteData("stats", "[user]", "Shows chess stats")
+	stats.AddTextArgument("Whose stats to show", "[@someone]", "")
+	chess.AddCommand(stats)
+
+	vs := model.NewAutocompleteData("vs", "[user]", "Shows your head-to-head record against a user")
+	vs.AddTextArgument("Opponent", "[@someone]", "")
+	chess.AddCommand(vs)
+
+	history := model.NewAutocompleteData("history", "[user] [page]", "Lists past games")
+	history.AddTextArgument("Whose games to list", "[@someone]", "")
+	history.AddTextArgument("Page", "[page]", "")
+	chess.AddCommand(history)
+
+	games := model.NewAutocompleteData("games", "", "Lists your games in progress")
+	chess.AddCommand(games)
+
+	board := model.NewAutocompleteData("board", "[game]", "Shows the board of a game as text")
+	board.AddTextArgument("Game ID, defaults to the game in this channel", "[game]", "")
+	chess.AddCommand(board)
+
+	annotate := model.NewAutocompleteData("annotate", "[game] arrows=... marks=...", "Draws arrows and circled squares on the board of a game")
+	annotate.AddTextArgument("Game ID, defaults to the game in this channel, then arrows=e2e4,g1f3:blue and marks=e4,d5:red", "[game] arrows=... marks=...", "")
+	chess.AddCommand(annotate)
+
+	display := model.NewAutocompleteData("display", "[image|text|blindfold]", "Changes how the board of the game is shown")
+	display.AddStaticListArgument("", true, []model.AutocompleteListItem{
+		{Item: boardDisplayImage, HelpText: "Show the board as an image"},
+		{Item: boardDisplayText, HelpText: "Show the board as text, for screen readers"},
+		{Item: boardDisplayBlindfold, HelpText: "Hide the board until the game is over"},
+	})
+	chess.AddCommand(display)
+
+	export := model.NewAutocompleteData("export", "[game]", "Shows the PGN of a game")
+	export.AddTextArgument("Game ID, defaults to the game in this channel", "[game]", "")
+	chess.AddCommand(export)
+
+	log := model.NewAutocompleteData("log", "[on|off]", "Replies to the game post with every move")
+	log.AddStaticListArgument("", true, []model.AutocompleteListItem{
+		{Item: "on", HelpText: "Log the moves in the game thread"},
+		{Item: "off", HelpText: "Stop logging the moves"},
+	})
+	chess.AddCommand(log)
+
+	replay := model.NewAutocompleteData("replay", "[game]", "Shares an animated replay of a finished game")
+	replay.AddTextArgument("Game ID, defaults to the game in this channel", "[game]", "")
+	chess.AddCommand(replay)
+
+	settings := model.NewAutocompleteData("settings", "[setting] [value]", "Shows or changes your settings")
+	notifications := model.NewAutocompleteData("notifications", "[dm|thread|off]", "How to be told it is your turn")
+	notifications.AddStaticListArgument("", true, []model.AutocompleteListItem{
+		{Item: NotificationsDM, HelpText: "Direct message from the bot"},
+		{Item: NotificationsThread, HelpText: "Reply in the game thread"},
+		{Item: NotificationsOff, HelpText: "No turn notifications, reminders are still sent"},
+	})
+	settings.AddCommand(notifications)
+	coordinates := model.NewAutocompleteData("coordinates", "[on|off]", "Show the rank and file labels on your board")
+	coordinates.AddStaticListArgument("", true, []model.AutocompleteListItem{
+		{Item: "on", HelpText: "Show the labels"},
+		{Item: "off", HelpText: "Hide the labels"},
+	})
+	settings.AddCommand(coordinates)
+	theme := model.NewAutocompleteData("theme", "[name|default]", "The colors of your board")
+	theme.AddTextArgument("Name of the theme, or default", "[name|default]", "")
+	settings.AddCommand(theme)
+	pieces := model.NewAutocompleteData("pieces", "[name|default]", "The piece set of your board")
+	pieceItems := []model.AutocompleteListItem{{Item: "default", HelpText: "The default piece set"}}
+	for _, name := range pieceSetNames() {
+		pieceItems = append(pieceItems, model.AutocompleteListItem{Item: name})
+	}
+	pieces.AddStaticListArgument("", true, pieceItems)
+	settings.AddCommand(pieces)
+	notation := model.NewAutocompleteData("notation", "[letters|figurine]", "How the pieces of the moves are written")
+	notation.AddStaticListArgument("", true, []model.AutocompleteListItem{
+		{Item: NotationLetters, HelpText: "The letters of your language, e.g. Sf3 in German"},
+		{Item: NotationFigurine, HelpText: "Figurines, e.g. ♘f3"},
+	})
+	settings.AddCommand(notation)
+	chess.AddCommand(settings)
+
 	return chess
 }
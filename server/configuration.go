@@ -2,6 +2,7 @@ package main
 
 import (
 	"reflect"
+	"time"
 
 	"github.com/pkg/errors"
 )
@@ -18,6 +19,22 @@ import (
 // If you add non-reference types to your configuration struct, be sure to rewrite Clone as a deep
 // copy appropriate for your types.
 type configuration struct {
+	// ReminderHours is how long a player can take to move before being reminded. Zero disables reminders.
+	ReminderHours int
+	// AbandonmentHours is how long a player can take to move before the opponent can claim the win. Zero disables it.
+	AbandonmentHours int
+	// PNGBoardImages makes the posts show the board as a PNG image instead of an SVG one.
+	PNGBoardImages bool
+	// BoardThemes are the board themes defined by the admin, see parseBoardThemes.
+	BoardThemes string
+	// DefaultBoardTheme is the theme of the boards shown in the channels.
+	DefaultBoardTheme string
+	// MoveLog posts the moves of every new game in the thread of the game post.
+	MoveLog bool
+	// ReplayFrameMilliseconds is how long each position is shown in the replays.
+	ReplayFrameMilliseconds int
+
+	boardThemes map[string]*BoardTheme
 }
 
 // Clone shallow copies the configuration. Your implementation may require a deep copy if
@@ -27,6 +44,26 @@ func (c *configuration) Clone() *configuration {
 	return &clone
 }
 
+func (c *configuration) reminderAfter() time.Duration {
+	return time.Duration(c.ReminderHours) * time.Hour
+}
+
+func (c *configuration) abandonmentAfter() time.Duration {
+	return time.Duration(c.AbandonmentHours) * time.Hour
+}
+
+// replayFrameDelay returns how long each position is shown in the replays, in
+// hundredths of a second.
+func (c *configuration) replayFrameDelay() int {
+	if c.ReplayFrameMilliseconds <= 0 {
+		return defaultReplayFrameDelay
+	}
+	if c.ReplayFrameMilliseconds < 10 {
+		return 1
+	}
+	return (c.ReplayFrameMilliseconds + 5) / 10
+}
+
 // getConfiguration retrieves the active configuration under lock, making it safe to use
 // concurrently. The active configuration may change underneath the client of this method, but
 // the struct returned by this API call is considered immutable.
@@ -77,6 +114,12 @@ func (p *Plugin) OnConfigurationChange() error {
 		return errors.Wrap(err, "failed to load plugin configuration")
 	}
 
+	themes, err := parseBoardThemes(configuration.BoardThemes)
+	if err != nil {
+		return errors.Wrap(err, "invalid board themes")
+	}
+	configuration.boardThemes = themes
+
 	p.setConfiguration(configuration)
 
 	return nil
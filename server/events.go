@@ -0,0 +1,119 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/notnil/chess"
+	"github.com/pkg/errors"
+)
+
+// MoveEvent records a move accepted by the plugin.
+type MoveEvent struct {
+	UserID string `json:"user_id"`
+	SAN    string `json:"san"`
+	UCI    string `json:"uci"`
+	// FEN is the position after the move.
+	FEN string `json:"fen"`
+	At  int64  `json:"at"`
+}
+
+// recordLastMove adds the last move of the game to its event log.
+func (g *Game) recordLastMove(userID string, at int64) {
+	moves := g.Moves()
+	if len(moves) == 0 {
+		return
+	}
+
+	move := moves[len(moves)-1]
+	position := g.Positions()[len(moves)-1]
+	g.Events = append(g.Events, &MoveEvent{
+		UserID: userID,
+		SAN:    chess.AlgebraicNotation{}.Encode(position, move),
+		UCI:    chess.UCINotation{}.Encode(position, move),
+		FEN:    g.FEN(),
+		At:     at,
+	})
+}
+
+// gameFromEvents rebuilds the position of a game by replaying its event log,
+// checking every resulting position against the recorded one.
+func gameFromEvents(events []*MoveEvent) (*chess.Game, error) {
+	game := chess.NewGame()
+	for i, event := range events {
+		move, err := chess.UCINotation{}.Decode(game.Position(), event.UCI)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid move %d", i+1)
+		}
+
+		err = game.Move(move)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid move %d", i+1)
+		}
+
+		if event.FEN != "" && game.FEN() != event.FEN {
+			return nil, errors.Errorf("move %d does not lead to the recorded position", i+1)
+		}
+	}
+
+	return game, nil
+}
+
+// eventForMove returns the event recorded for the move at index i, if any. Games
+// started before the event log existed only have events for their latest moves.
+func (g *Game) eventForMove(i int) *MoveEvent {
+	offset := len(g.Moves()) - len(g.Events)
+	if i < offset || i-offset >= len(g.Events) {
+		return nil
+	}
+	return g.Events[i-offset]
+}
+
+// ExportPGN returns the game in PGN, with the seven tag roster, the opening and the
+// time each move was played as a comment.
+func ExportPGN(game *Game, siteURL, whiteName, blackName string) string {
+	date := time.Unix(0, game.CreatedAt*int64(time.Millisecond)).UTC()
+
+	sb := &strings.Builder{}
+	fmt.Fprintf(sb, "[Event \"Mattermost chess game\"]\n")
+	fmt.Fprintf(sb, "[Site \"%s\"]\n", siteURL)
+	fmt.Fprintf(sb, "[Date \"%s\"]\n", date.Format("2006.01.02"))
+	fmt.Fprintf(sb, "[Round \"-\"]\n")
+	fmt.Fprintf(sb, "[White \"%s\"]\n", whiteName)
+	fmt.Fprintf(sb, "[Black \"%s\"]\n", blackName)
+	fmt.Fprintf(sb, "[Result \"%s\"]\n", game.Outcome())
+	if game.Termination != "" {
+		fmt.Fprintf(sb, "[Termination \"%s\"]\n", game.Termination)
+	}
+	if game.ECO != "" {
+		fmt.Fprintf(sb, "[ECO \"%s\"]\n", game.ECO)
+		fmt.Fprintf(sb, "[Opening \"%s\"]\n", game.Opening)
+	}
+	sb.WriteString("\n")
+
+	positions := game.Positions()
+	afterComment := false
+	for i, move := range game.Moves() {
+		switch {
+		case i%2 == 0:
+			fmt.Fprintf(sb, "%d. ", i/2+1)
+		case afterComment:
+			// Black moves following a comment repeat the move number.
+			fmt.Fprintf(sb, "%d... ", i/2+1)
+		}
+		sb.WriteString(chess.AlgebraicNotation{}.Encode(positions[i], move))
+
+		afterComment = false
+		if event := game.eventForMove(i); event != nil {
+			at := time.Unix(0, event.At*int64(time.Millisecond)).UTC()
+			fmt.Fprintf(sb, " {[%%timestamp %s]}", at.Format(time.RFC3339))
+			afterComment = true
+		}
+		sb.WriteString(" ")
+	}
+	sb.WriteString(game.Outcome().String())
+	sb.WriteString("\n")
+
+	return sb.String()
+}
@@ -0,0 +1,185 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/notnil/chess"
+	"github.com/pkg/errors"
+)
+
+const (
+	// gameRecordVersion is the version of the stored game schema. Bump it and add
+	// a conversion in decodeGameRecord when the schema changes.
+	gameRecordVersion = 1
+
+	variantStandard = "standard"
+
+	terminationAbandoned = "abandoned"
+	terminationAborted   = "aborted"
+)
+
+// GameMetadata is everything the plugin keeps about a game besides its moves.
+type GameMetadata struct {
+	ID        string `json:"id"`
+	ChannelID string `json:"channel_id"`
+	PostID    string `json:"post_id"`
+	WhiteID   string `json:"white_id"`
+	BlackID   string `json:"black_id"`
+	Variant   string `json:"variant"`
+	// TimeControl is empty for correspondence games. Clocks hold the remaining
+	// milliseconds for each side when there is a time control.
+	TimeControl string `json:"time_control,omitempty"`
+	WhiteClock  int64  `json:"white_clock,omitempty"`
+	BlackClock  int64  `json:"black_clock,omitempty"`
+
+	CreatedAt      int64 `json:"created_at"`
+	UpdatedAt      int64 `json:"updated_at"`
+	LastMoveAt     int64 `json:"last_move_at"`
+	RemindedAt     int64 `json:"reminded_at,omitempty"`
+	ClaimOfferedAt int64 `json:"claim_offered_at,omitempty"`
+
+	// Termination is set when the game did not end over the board, e.g. "abandoned" or "aborted".
+	Termination string `json:"termination,omitempty"`
+	// EndMethod keeps the method the game ended by, since PGN does not store it.
+	EndMethod chess.Method `json:"method,omitempty"`
+
+	Events []*MoveEvent `json:"events,omitempty"`
+
+	// RematchOfferedBy is the player waiting for the opponent to accept a rematch.
+	RematchOfferedBy string `json:"rematch_offered_by,omitempty"`
+	RematchGameID    string `json:"rematch_game_id,omitempty"`
+
+	// MoveLog posts every move as a reply in the thread of the game post.
+	MoveLog bool `json:"move_log,omitempty"`
+	// Display is how the board is shown, one of boardDisplays. Empty is an image.
+	Display string `json:"display,omitempty"`
+
+	// ECO and Opening are the code and name of the opening the moves follow,
+	// updated after every move.
+	ECO     string `json:"eco,omitempty"`
+	Opening string `json:"opening,omitempty"`
+}
+
+// Game is a chess game together with its metadata.
+type Game struct {
+	*chess.Game
+	GameMetadata
+}
+
+type gameRecord struct {
+	Version int `json:"version"`
+	GameMetadata
+	PGN string `json:"pgn"`
+}
+
+// Method returns the method the game ended by, even after being reloaded from PGN.
+func (g *Game) Method() chess.Method {
+	if m := g.Game.Method(); m != chess.NoMethod {
+		return m
+	}
+	return g.EndMethod
+}
+
+// IsOver reports whether the game has finished, either with a result or aborted.
+func (g *Game) IsOver() bool {
+	return g.Outcome() != chess.NoOutcome || g.Termination == terminationAborted
+}
+
+// HidesBoard reports whether the board is hidden from the players, which is the
+// case during blindfold games.
+func (g *Game) HidesBoard() bool {
+	return g.Display == boardDisplayBlindfold && !g.IsOver()
+}
+
+// CanAbort reports whether the game can still be aborted, that is, until each side has moved once.
+func (g *Game) CanAbort() bool {
+	return !g.IsOver() && len(g.Moves()) < 2
+}
+
+// PlayerToMove returns the ID of the player whose turn it is.
+func (g *Game) PlayerToMove() string {
+	if g.Position().Turn() == chess.Black {
+		return g.BlackID
+	}
+	return g.WhiteID
+}
+
+// WaitingPlayer returns the ID of the player waiting for the opponent to move.
+func (g *Game) WaitingPlayer() string {
+	if g.Position().Turn() == chess.Black {
+		return g.WhiteID
+	}
+	return g.BlackID
+}
+
+// Opponent returns the ID of the other player of the game.
+func (g *Game) Opponent(userID string) string {
+	if g.WhiteID == userID {
+		return g.BlackID
+	}
+	return g.WhiteID
+}
+
+// IsPlayer reports whether userID plays this game.
+func (g *Game) IsPlayer(userID string) bool {
+	return g.WhiteID == userID || g.BlackID == userID
+}
+
+// ColorOf returns the color userID plays with, or chess.NoColor if they are not playing.
+func (g *Game) ColorOf(userID string) chess.Color {
+	switch userID {
+	case g.WhiteID:
+		return chess.White
+	case g.BlackID:
+		return chess.Black
+	}
+	return chess.NoColor
+}
+
+func encodeGameRecord(game *Game) ([]byte, error) {
+	record := gameRecord{
+		Version:      gameRecordVersion,
+		GameMetadata: game.GameMetadata,
+		PGN:          game.Game.String(),
+	}
+	if m := game.Game.Method(); m != chess.NoMethod {
+		record.EndMethod = m
+	}
+
+	return json.Marshal(record)
+}
+
+func decodeGameRecord(b []byte) (*Game, error) {
+	record := gameRecord{}
+	err := json.Unmarshal(b, &record)
+	if err != nil {
+		return nil, err
+	}
+
+	if record.Version != gameRecordVersion {
+		return nil, errors.Errorf("unsupported game record version %d", record.Version)
+	}
+
+	pgn, err := chess.PGN(bytes.NewBufferString(record.PGN))
+	if err != nil {
+		if len(record.Events) == 0 {
+			return nil, err
+		}
+
+		// The event log holds every move, so the game can still be rebuilt from it.
+		replayed, replayErr := gameFromEvents(record.Events)
+		if replayErr != nil {
+			return nil, err
+		}
+		return &Game{
+			Game:         replayed,
+			GameMetadata: record.GameMetadata,
+		}, nil
+	}
+
+	return &Game{
+		Game:         chess.NewGame(pgn),
+		GameMetadata: record.GameMetadata,
+	}, nil
+}
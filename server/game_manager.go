@@ -1,401 +1,740 @@
 package main
 
 import (
-	"bytes"
 	"crypto/rand"
-	"errors"
 	"fmt"
-	"image/color"
 	"math/big"
-	"net/http"
-	"net/url"
-	"regexp"
-	"strings"
 
+	"github.com/mattermost/mattermost-plugin-api/i18n"
 	"github.com/mattermost/mattermost-server/v5/model"
 	"github.com/mattermost/mattermost-server/v5/plugin"
 	"github.com/notnil/chess"
-	chessImage "github.com/notnil/chess/image"
-)
-
-const (
-	whiteTag   = "white"
-	blackTag   = "black"
-	channelTag = "channel"
-	postTag    = "post"
+	"github.com/pkg/errors"
 )
 
 type GameManager struct {
 	api              plugin.API
+	store            GameStore
 	botID            string
 	grantAchievement func(name string, userID string)
+	getConfiguration func() *configuration
+	// imageKey signs the URLs of the board images.
+	imageKey   []byte
+	imageCache *imageCache
+	// replays are the replays being drawn.
+	replays *replayRenders
+	// bundle holds the translations of the messages.
+	bundle *i18n.Bundle
 }
 
-func NewGameManager(api plugin.API, botID string, grantAchievement func(name string, userID string)) GameManager {
+func NewGameManager(api plugin.API, store GameStore, botID string, grantAchievement func(name string, userID string), getConfiguration func() *configuration) GameManager {
 	return GameManager{
 		api:              api,
+		store:            store,
 		botID:            botID,
 		grantAchievement: grantAchievement,
+		getConfiguration: getConfiguration,
+		imageCache:       newImageCache(imageCacheSize),
+		replays:          newReplayRenders(),
 	}
 }
 
 func (gm *GameManager) CreateGame(playerA, playerB string) error {
-	c, appErr := gm.api.GetDirectChannel(playerA, playerB)
+	whiteID, blackID := playerA, playerB
+	r, _ := rand.Int(rand.Reader, big.NewInt(1))
+	if r.Int64() != 0 {
+		whiteID, blackID = playerB, playerA
+	}
+
+	_, err := gm.createGame(GameMetadata{
+		WhiteID: whiteID,
+		BlackID: blackID,
+		Variant: variantStandard,
+	})
+	return err
+}
+
+// createGame starts a game in the direct channel between the players, with the
+// players, variant and time control given in metadata.
+func (gm *GameManager) createGame(metadata GameMetadata) (*Game, error) {
+	c, appErr := gm.api.GetDirectChannel(metadata.WhiteID, metadata.BlackID)
 	if appErr != nil {
-		return appErr
+		return nil, appErr
 	}
 
-	originalGame := gm.getGame(c.Id)
-	if originalGame != nil {
-		if originalGame.Outcome() == chess.NoOutcome {
-			return errors.New("still an active game")
-		}
+	unlock, err := gm.lockChannel(c.Id)
+	if err != nil {
+		return nil, err
 	}
+	defer unlock()
 
-	game := chess.NewGame()
-	r, _ := rand.Int(rand.Reader, big.NewInt(1))
-	if r.Int64() == 0 {
-		game.AddTagPair(whiteTag, playerA)
-		game.AddTagPair(blackTag, playerB)
-	} else {
-		game.AddTagPair(whiteTag, playerB)
-		game.AddTagPair(blackTag, playerA)
+	originalGame, err := gm.getChannelGame(c.Id)
+	if err != nil && err != ErrNotFound {
+		return nil, err
+	}
+	if originalGame != nil && !originalGame.IsOver() {
+		return nil, newUserError(&i18n.Message{
+			ID:    "chess.error.active_game",
+			Other: "there is still an active game in this channel",
+		}, nil)
+	}
+
+	now := model.GetMillis()
+	game := &Game{
+		Game: chess.NewGame(),
+		GameMetadata: GameMetadata{
+			ID:          model.NewId(),
+			ChannelID:   c.Id,
+			WhiteID:     metadata.WhiteID,
+			BlackID:     metadata.BlackID,
+			Variant:     metadata.Variant,
+			TimeControl: metadata.TimeControl,
+			MoveLog:     metadata.MoveLog || gm.getConfiguration().MoveLog,
+			Display:     metadata.Display,
+			CreatedAt:   now,
+			LastMoveAt:  now,
+		},
+	}
+
+	post, appErr := gm.api.CreatePost(gm.gameToPost(game))
+	if appErr != nil {
+		return nil, errors.Wrap(appErr, "could not post the game")
 	}
-	game.AddTagPair(channelTag, c.Id)
+	game.PostID = post.Id
 
-	gm.saveGame(game)
+	err = gm.saveGame(game)
+	if err != nil {
+		return nil, err
+	}
 
-	post, _ := gm.api.CreatePost(gm.gameToPost(game))
-	game.AddTagPair(postTag, post.Id)
-	gm.saveGame(game)
-	return nil
+	err = gm.store.SetChannelGameID(c.Id, game.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not save the game")
+	}
+
+	gm.addActiveGame(game)
+	return game, nil
 }
 
 func (gm *GameManager) Move(id, player, movement string) (*model.Post, error) {
-	game := gm.getGame(id)
-	if game == nil {
-		return nil, errors.New("no game started")
+	game, unlock, err := gm.getLockedGame(id)
+	if err != nil {
+		return nil, err
 	}
+	defer unlock()
 
-	_, _, whiteUser, blackUser := gm.getGameMetadata(game)
+	if game.IsOver() {
+		return nil, newUserError(messageGameOver, nil)
+	}
 
-	turn := whiteUser
-	if game.Position().Turn() == chess.Black {
-		turn = blackUser
+	if player != game.PlayerToMove() {
+		return nil, newUserError(&i18n.Message{
+			ID:    "chess.error.not_your_turn",
+			Other: "it is not your turn",
+		}, nil)
 	}
 
-	if player != turn.Id {
-		return nil, errors.New("it is not your turn")
+	err = game.MoveStr(gm.userNotation(player).parse(movement))
+	if err != nil {
+		return nil, newUserError(&i18n.Message{
+			ID:    "chess.error.invalid_move",
+			Other: "{{.Move}} is not a valid move",
+		}, map[string]interface{}{"Move": movement})
 	}
 
-	err := game.MoveStr(movement)
+	game.classifyOpening()
+
+	now := model.GetMillis()
+	game.recordLastMove(player, now)
+	game.LastMoveAt = now
+	game.RemindedAt = 0
+	game.ClaimOfferedAt = 0
+
+	if game.Outcome() != chess.NoOutcome {
+		err = gm.finishGame(game)
+		if err != nil {
+			return nil, err
+		}
+		gm.logMove(game)
+		return gm.gameToPost(game), nil
+	}
+
+	err = gm.saveGame(game)
 	if err != nil {
 		return nil, err
 	}
 
-	gm.saveGame(game)
+	gm.logMove(game)
+	gm.notifyTurn(game)
 	return gm.gameToPost(game), nil
 }
 
 func (gm *GameManager) Resign(id, player string) (*model.Post, error) {
-	game := gm.getGame(id)
-	if game == nil {
-		return nil, errors.New("no game started")
+	game, unlock, err := gm.getLockedGame(id)
+	if err != nil {
+		return nil, err
 	}
+	defer unlock()
 
-	_, _, whiteUser, blackUser := gm.getGameMetadata(game)
+	if game.IsOver() {
+		return nil, newUserError(messageGameOver, nil)
+	}
 
-	switch player {
-	case whiteUser.Id:
-		game.Resign(chess.White)
-	case blackUser.Id:
-		game.Resign(chess.Black)
-	default:
-		return nil, errors.New("you are not playing")
+	color := game.ColorOf(player)
+	if color == chess.NoColor {
+		return nil, newUserError(messageNotPlaying, nil)
+	}
+	game.Resign(color)
+
+	err = gm.finishGame(game)
+	if err != nil {
+		return nil, err
 	}
 
-	gm.saveGame(game)
 	return gm.gameToPost(game), nil
 }
 
-func (gm *GameManager) getGame(id string) *chess.Game {
-	b, appErr := gm.api.KVGet(id)
-	if appErr != nil {
-		return nil
+// Abort ends the game without a result. It is only possible until both players
+// have made their first move.
+func (gm *GameManager) Abort(id, player string) (*model.Post, error) {
+	game, unlock, err := gm.getLockedGame(id)
+	if err != nil {
+		return nil, err
 	}
+	defer unlock()
 
-	pgn, err := chess.PGN(bytes.NewReader(b))
+	if game.IsOver() {
+		return nil, newUserError(messageGameOver, nil)
+	}
+
+	if !game.IsPlayer(player) {
+		return nil, newUserError(messageNotPlaying, nil)
+	}
+
+	if !game.CanAbort() {
+		return nil, newUserError(&i18n.Message{
+			ID:    "chess.error.abort",
+			Other: "the game can only be aborted before both players have moved",
+		}, nil)
+	}
+
+	game.Termination = terminationAborted
+	err = gm.finishGame(game)
 	if err != nil {
-		return nil
+		return nil, err
 	}
 
-	return chess.NewGame(pgn)
+	return gm.gameToPost(game), nil
 }
 
-func (gm *GameManager) saveGame(game *chess.Game) {
-	id, _, _, _ := gm.getGameMetadata(game)
-	_ = gm.api.KVSet(id, []byte(game.String()))
+// finishGame stores a game that just ended and moves it out of the active games.
+func (gm *GameManager) finishGame(game *Game) error {
+	err := gm.archiveGame(game)
+	if err != nil {
+		return errors.Wrap(err, "could not archive the game")
+	}
+
+	err = gm.saveGame(game)
+	if err != nil {
+		return err
+	}
+
+	gm.removeActiveGame(game)
+	return nil
 }
 
-func (gm *GameManager) GetBoardLink(gameID string) string {
-	game := gm.getGame(gameID)
-	if game == nil {
-		return ""
+// getGame returns the game with the given ID. Posts created before games had
+// their own ID refer to them by channel, so channel IDs are accepted too.
+func (gm *GameManager) getGame(id string) (*Game, error) {
+	game, err := gm.store.GetGame(id)
+	if err != ErrNotFound {
+		return game, err
 	}
 
-	return gm.getBoardLink(game)
+	game, err = gm.getChannelGame(id)
+	if err == ErrNotFound {
+		return nil, newUserError(&i18n.Message{
+			ID:    "chess.error.no_game",
+			Other: "no game started",
+		}, nil)
+	}
+	return game, err
 }
 
-func (gm *GameManager) getBoardLink(game *chess.Game) string {
-	baseURL := gm.api.GetConfig().ServiceSettings.SiteURL
+func (gm *GameManager) getChannelGame(channelID string) (*Game, error) {
+	gameID, err := gm.store.GetChannelGameID(channelID)
+	if err != nil {
+		return nil, err
+	}
 
-	fen := game.FEN()
-	movements := game.Moves()
-	from := ""
-	to := ""
-	check := ""
-	capture := ""
-	if len(movements) > 0 {
-		lastMovement := movements[len(movements)-1]
-		from = lastMovement.S1().String()
-		to = lastMovement.S2().String()
-		if lastMovement.HasTag(chess.Check) {
-			squareMap := game.Position().Board().SquareMap()
-			for square, piece := range squareMap {
-				if piece.Type() == chess.King && piece.Color() == squareMap[lastMovement.S2()].Color().Other() {
-					check = square.String()
-				}
-			}
+	return gm.store.GetGame(gameID)
+}
+
+// getLockedGame locks the game and loads it. The caller must call unlock once done.
+func (gm *GameManager) getLockedGame(id string) (*Game, func(), error) {
+	game, err := gm.getGame(id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	unlock, err := gm.lockGame(game.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Reload, as the game may have changed while waiting for the lock.
+	game, err = gm.store.GetGame(game.ID)
+	if err != nil {
+		unlock()
+		return nil, nil, err
+	}
+
+	return game, unlock, nil
+}
+
+func (gm *GameManager) saveGame(game *Game) error {
+	game.UpdatedAt = model.GetMillis()
+	err := gm.store.SaveGame(game)
+	if err != nil {
+		return errors.Wrap(err, "could not save the game")
+	}
+
+	return nil
+}
+
+// ExportGame returns the PGN of the game, as long as userID can see the game channel.
+func (gm *GameManager) ExportGame(id, userID string) (string, error) {
+	game, err := gm.getGame(id)
+	if err != nil {
+		return "", err
+	}
+
+	if !game.IsPlayer(userID) {
+		if _, appErr := gm.api.GetChannelMember(game.ChannelID, userID); appErr != nil {
+			return "", newUserError(messageCannotSee, nil)
 		}
-		if lastMovement.HasTag(chess.Capture) {
-			capture = lastMovement.S2().String()
-			if lastMovement.HasTag(chess.EnPassant) {
-				capture = lastMovement.S2().File().String() + lastMovement.S1().Rank().String()
-			}
+	}
+
+	whiteUser, blackUser := gm.getPlayers(game)
+	siteURL := *gm.api.GetConfig().ServiceSettings.SiteURL
+	return ExportPGN(game, siteURL, whiteUser.Username, blackUser.Username), nil
+}
+
+// ShareReplay posts an animated GIF of the finished game in the channel on behalf
+// of userID. The GIF is seen from the side of the user if they played the game, with
+// their theme and piece set. Drawing it takes a while for long games, so it is done
+// in the background once the user is known to be able to see the game; errors from
+// then on are sent to the user. Only one replay is drawn at a time for each user and game.
+func (gm *GameManager) ShareReplay(id, userID, channelID, rootID string) error {
+	game, err := gm.getGame(id)
+	if err != nil {
+		return err
+	}
+
+	if !game.IsPlayer(userID) {
+		if _, appErr := gm.api.GetChannelMember(game.ChannelID, userID); appErr != nil {
+			return newUserError(messageCannotSee, nil)
 		}
 	}
+	if !game.IsOver() {
+		return newUserError(&i18n.Message{
+			ID:    "chess.error.replay_unfinished",
+			Other: "only finished games can be replayed",
+		}, nil)
+	}
+
+	renders := []string{"user_" + userID, "game_" + game.ID}
+	if !gm.replays.start(renders...) {
+		return newUserError(&i18n.Message{
+			ID:    "chess.error.replay_in_progress",
+			Other: "a replay is already being drawn, try again once it is posted",
+		}, nil)
+	}
+
+	prefs := gm.GetUserPreferences(userID)
+	config := gm.getConfiguration()
+	theme := config.boardTheme(prefs.Theme)
+	delay := config.replayFrameDelay()
+	go func() {
+		defer gm.replays.finish(renders...)
+		if err := gm.postReplay(game, userID, channelID, rootID, prefs, theme, delay); err != nil {
+			gm.api.LogError("could not share the replay", "game", game.ID, "error", err.Error())
+			l := gm.userLocalizer(userID)
+			gm.api.SendEphemeralPost(userID, &model.Post{
+				UserId:    gm.botID,
+				ChannelId: channelID,
+				RootId:    rootID,
+				Message: gm.localize(l, &i18n.Message{
+					ID:    "chess.replay.error",
+					Other: "Could not share the replay of the game.",
+				}, nil),
+			})
+		}
+	}()
 
-	imageURL, _ := url.Parse(fmt.Sprintf("%s/plugins/%s/image.svg", *baseURL, manifest.Id))
-	q := imageURL.Query()
-	q.Set("fen", fen)
-	q.Set("from", from)
-	q.Set("to", to)
-	q.Set("check", check)
-	q.Set("capture", capture)
-	imageURL.RawQuery = q.Encode()
-	return imageURL.String()
+	return nil
 }
 
-func (gm *GameManager) gameToPost(game *chess.Game) *model.Post {
-	channelID, postID, whiteUser, blackUser := gm.getGameMetadata(game)
+func (gm *GameManager) postReplay(game *Game, userID, channelID, rootID string, prefs *UserPreferences, theme *BoardTheme, delay int) error {
+	replay, err := renderReplayGIF(game.Game, viewerOrientation(game, userID), prefs, theme, delay)
+	if err != nil {
+		return err
+	}
+
+	fileInfo, appErr := gm.api.UploadFile(replay, channelID, fmt.Sprintf("chess-%s.gif", game.ID))
+	if appErr != nil {
+		return appErr
+	}
+
+	_, appErr = gm.api.CreatePost(&model.Post{
+		UserId:    userID,
+		ChannelId: channelID,
+		RootId:    rootID,
+		FileIds:   []string{fileInfo.Id},
+	})
+	return appErrToError(appErr)
+}
+
+// GetChannelGameID returns the ID of the latest game played in the channel.
+func (gm *GameManager) GetChannelGameID(channelID string) (string, error) {
+	id, err := gm.store.GetChannelGameID(channelID)
+	if err == ErrNotFound {
+		return "", newUserError(&i18n.Message{
+			ID:    "chess.error.no_channel_game",
+			Other: "no game has been played in this channel",
+		}, nil)
+	}
+	return id, err
+}
+
+// GetBoardView returns the board of the game as markdown, seen from the side userID
+// plays, or from White's side if they are not playing the game. The board is an
+// image or text depending on the display of the game, and is empty while it is
+// hidden from the players.
+func (gm *GameManager) GetBoardView(gameID, userID string) string {
+	game, err := gm.getGame(gameID)
+	if err != nil {
+		return ""
+	}
+
+	return gm.userBoardView(game, userID, len(game.Moves()))
+}
+
+// userBoardView returns the board of the game after ply moves as markdown, as the
+// user likes to see it.
+func (gm *GameManager) userBoardView(game *Game, userID string, ply int) string {
+	switch {
+	case game.HidesBoard():
+		return ""
+	case game.Display == boardDisplayText:
+		return gm.textBoard(gm.userLocalizer(userID), game.Positions()[ply].Board(), viewerOrientation(game, userID), gm.userNotation(userID))
+	default:
+		return "![board](" + gm.userBoardLink(game, userID, ply) + ")"
+	}
+}
+
+// userBoardLink returns the link to the board of the game after ply moves, as the
+// user likes to see it: from their side, with their theme.
+func (gm *GameManager) userBoardLink(game *Game, userID string, ply int) string {
+	return gm.boardImageURL(gm.userBoardImage(game, userID, ply))
+}
+
+// userBoardImage returns the board of the game after ply moves, as the user likes
+// to see it.
+func (gm *GameManager) userBoardImage(game *Game, userID string, ply int) *BoardImage {
+	var lastMovement *chess.Move
+	if ply > 0 {
+		lastMovement = game.Moves()[ply-1]
+	}
+
+	prefs := gm.GetUserPreferences(userID)
+	img := boardImageForPosition(game.Positions()[ply], lastMovement, viewerOrientation(game, userID))
+	img.Coordinates = prefs.Coordinates
+	img.Theme = prefs.Theme
+	img.Pieces = prefs.Pieces
+	return img
+}
+
+// viewerOrientation returns the side the user plays in the game, or White if they
+// are not playing it.
+func viewerOrientation(game *Game, userID string) chess.Color {
+	orientation := game.ColorOf(userID)
+	if orientation == chess.NoColor {
+		return chess.White
+	}
+	return orientation
+}
+
+func (gm *GameManager) boardImageURL(img *BoardImage) string {
+	if gm.getConfiguration().PNGBoardImages {
+		img.Format = boardImagePNG
+	}
+
+	q := img.query()
+	q.Set(imageSignatureParam, signImage(gm.imageKey, img.Format, q))
+
+	baseURL := gm.api.GetConfig().ServiceSettings.SiteURL
+	return fmt.Sprintf("%s/plugins/%s/image.%s?%s", *baseURL, manifest.Id, img.Format, q.Encode())
+}
+
+func (gm *GameManager) gameToPost(game *Game) *model.Post {
+	whiteUser, blackUser := gm.getPlayers(game)
+	// The post is shared by everyone, so it is written in the language of the server.
+	l := gm.serverLocalizer()
 
 	baseURL := gm.api.GetConfig().ServiceSettings.SiteURL
 	post := &model.Post{
-		Id:        postID,
-		ChannelId: channelID,
+		Id:        game.PostID,
+		ChannelId: game.ChannelID,
 		UserId:    gm.botID,
 	}
 
-	turn := "White"
-	if game.Position().Turn() == chess.Black {
-		turn = "Black"
+	turn := gm.localizeColor(l, game.Position().Turn())
+
+	// The board is shown from the side to move while the game goes on.
+	orientation := chess.White
+	if !game.IsOver() {
+		orientation = game.Position().Turn()
 	}
 
 	attachment := &model.SlackAttachment{
-		Title:    "Chess game",
-		ImageURL: gm.getBoardLink(game),
-		Text:     fmt.Sprintf("White: %s\nBlack: %s", whiteUser.Username, blackUser.Username),
+		Title: gm.localize(l, messageGameTitle, nil),
+		Text:  gm.localize(l, messagePlayers, map[string]interface{}{"White": whiteUser.Username, "Black": blackUser.Username}),
+	}
+	switch {
+	case game.HidesBoard():
+		attachment.Text += "\n" + gm.localize(l, &i18n.Message{
+			ID:    "chess.game.blindfold",
+			Other: "Blindfold game: the board is shown when the game is over.",
+		}, nil)
+	case game.Display == boardDisplayText:
+		attachment.Text += "\n" + gm.textBoard(l, game.Position().Board(), orientation, englishNotation)
+	default:
+		attachment.ImageURL = gm.boardImageURL(boardImageForGame(game.Game, orientation))
 	}
 
 	movements := game.Moves()
+	if len(movements) > 0 {
+		moves, truncated := recentMoves(game.Game, recentMovesShown)
+		if truncated {
+			moves = gm.localize(l, &i18n.Message{
+				ID:    "chess.game.truncated_moves",
+				Other: "… {{.Moves}} ([full game]({{.URL}}))",
+			}, map[string]interface{}{"Moves": moves, "URL": fmt.Sprintf("%s/plugins/%s/pgn/%s", *baseURL, manifest.Id, game.ID)})
+		}
+		if name := game.OpeningName(); name != "" {
+			attachment.Text += "\n" + gm.localize(l, &i18n.Message{
+				ID:    "chess.game.opening",
+				Other: "Opening: {{.Opening}}",
+			}, map[string]interface{}{"Opening": name})
+		}
+		board := game.Position().Board()
+		attachment.Text += "\n" + gm.localize(l, &i18n.Message{
+			ID:    "chess.game.summary",
+			Other: "Moves: {{.Moves}}\nWhite captured: {{.WhiteCaptures}}\nBlack captured: {{.BlackCaptures}}",
+		}, map[string]interface{}{
+			"Moves":         moves,
+			"WhiteCaptures": gm.formatCaptures(l, board, chess.White),
+			"BlackCaptures": gm.formatCaptures(l, board, chess.Black),
+		})
+	}
+
 	check := false
 	promoPiece := ""
 	if len(movements) > 0 {
 		lastMovement := movements[len(movements)-1]
 		check = lastMovement.HasTag(chess.Check)
 		if lastMovement.Promo() != chess.NoPieceType {
-			promoPiece = pieceToPieceName[lastMovement.Promo()]
+			promoPiece = gm.localizePiece(l, lastMovement.Promo())
 		}
 	}
 
 	if promoPiece != "" {
-		attachment.Text += "\nPawn promoted to " + promoPiece
+		attachment.Text += "\n" + gm.localize(l, messagePromotion, map[string]interface{}{"Piece": promoPiece})
 	}
 	if check {
-		attachment.Text += "\nCHECK!"
+		attachment.Text += "\n" + gm.localize(l, messageCheck, nil)
+	}
+
+	attachment.Text += "\n" + gm.localize(l, messageTurn, map[string]interface{}{"Color": turn})
+	method := gm.localizeMethod(l, game.Method())
+	if game.Termination == terminationAbandoned {
+		method = gm.localize(l, messageAbandonment, nil)
 	}
 
-	attachment.Text += "\nTurn: " + turn
 	switch game.Outcome() {
 	case chess.NoOutcome:
+		if game.Termination == terminationAborted {
+			attachment.Footer = gm.localize(l, &i18n.Message{
+				ID:    "chess.game.aborted",
+				Other: "Game aborted.",
+			}, nil)
+			break
+		}
 		attachment.Actions = []*model.PostAction{
 			{
 				Type: "button",
-				Name: "Move",
+				Name: gm.localize(l, &i18n.Message{
+					ID:    "chess.game.button.move",
+					Other: "Move",
+				}, nil),
 				Integration: &model.PostActionIntegration{
-					URL: fmt.Sprintf("%s/plugins/%s/move/%s", *baseURL, manifest.Id, channelID),
+					URL: fmt.Sprintf("%s/plugins/%s/move/%s", *baseURL, manifest.Id, game.ID),
 				},
 			},
 			{
 				Type: "button",
-				Name: "Resign",
+				Name: gm.localize(l, &i18n.Message{
+					ID:    "chess.game.button.resign",
+					Other: "Resign",
+				}, nil),
 				Integration: &model.PostActionIntegration{
-					URL: fmt.Sprintf("%s/plugins/%s/resign/%s", *baseURL, manifest.Id, channelID),
+					URL: fmt.Sprintf("%s/plugins/%s/resign/%s", *baseURL, manifest.Id, game.ID),
 				},
 			},
 		}
+		if game.CanAbort() {
+			attachment.Actions = append(attachment.Actions, &model.PostAction{
+				Type: "button",
+				Name: gm.localize(l, &i18n.Message{
+					ID:    "chess.game.button.abort",
+					Other: "Abort",
+				}, nil),
+				Integration: &model.PostActionIntegration{
+					URL: fmt.Sprintf("%s/plugins/%s/abort/%s", *baseURL, manifest.Id, game.ID),
+				},
+			})
+		}
 	case chess.BlackWon:
 		gm.grantAchievement(AchievementNameWinner, blackUser.Id)
-		attachment.Footer = fmt.Sprintf("Black won by %s!", translateMethod(game.Method()))
+		attachment.Footer = gm.localize(l, &i18n.Message{
+			ID:    "chess.game.black_won",
+			Other: "Black won by {{.Method}}!",
+		}, map[string]interface{}{"Method": method})
 	case chess.WhiteWon:
 		gm.grantAchievement(AchievementNameWinner, whiteUser.Id)
-		attachment.Footer = fmt.Sprintf("White won by %s!", translateMethod(game.Method()))
+		attachment.Footer = gm.localize(l, &i18n.Message{
+			ID:    "chess.game.white_won",
+			Other: "White won by {{.Method}}!",
+		}, map[string]interface{}{"Method": method})
 	case chess.Draw:
-		attachment.Footer = fmt.Sprintf("Draw due to %s!", translateMethod(game.Method()))
+		attachment.Footer = gm.localize(l, &i18n.Message{
+			ID:    "chess.game.draw",
+			Other: "Draw due to {{.Method}}!",
+		}, map[string]interface{}{"Method": method})
+	}
+
+	if game.IsOver() {
+		text, actions := gm.rematchAttachmentParts(l, game)
+		attachment.Text += text
+		attachment.Actions = append(gm.browseActions(game, len(movements)), actions...)
 	}
 
 	model.ParseSlackAttachment(post, []*model.SlackAttachment{attachment})
 	return post
 }
 
-func translateMethod(m chess.Method) string {
+// localizeMethod returns the name of the method a game ended by.
+func (gm *GameManager) localizeMethod(l *i18n.Localizer, m chess.Method) string {
+	message := &i18n.Message{ID: "chess.method.unknown", Other: "Unknown method"}
 	switch m {
 	case chess.Checkmate:
-		return "Checkmate"
+		message = &i18n.Message{ID: "chess.method.checkmate", Other: "Checkmate"}
 	case chess.DrawOffer:
-		return "Draw offer"
+		message = &i18n.Message{ID: "chess.method.draw_offer", Other: "Draw offer"}
 	case chess.FiftyMoveRule:
-		return "Fifty move rule"
+		message = &i18n.Message{ID: "chess.method.fifty_move_rule", Other: "Fifty move rule"}
 	case chess.FivefoldRepetition:
-		return "Fivefold Repetition"
+		message = &i18n.Message{ID: "chess.method.fivefold_repetition", Other: "Fivefold repetition"}
 	case chess.InsufficientMaterial:
-		return "Insuficient Material"
+		message = &i18n.Message{ID: "chess.method.insufficient_material", Other: "Insufficient material"}
 	case chess.NoMethod:
-		return "No method"
+		message = &i18n.Message{ID: "chess.method.none", Other: "No method"}
 	case chess.Resignation:
-		return "Resignation"
+		message = &i18n.Message{ID: "chess.method.resignation", Other: "Resignation"}
 	case chess.SeventyFiveMoveRule:
-		return "Seventy five move rule"
+		message = &i18n.Message{ID: "chess.method.seventy_five_move_rule", Other: "Seventy five move rule"}
 	case chess.Stalemate:
-		return "Stalemate"
+		message = &i18n.Message{ID: "chess.method.stalemate", Other: "Stalemate"}
 	case chess.ThreefoldRepetition:
-		return "Threefold repetition"
+		message = &i18n.Message{ID: "chess.method.threefold_repetition", Other: "Threefold repetition"}
 	}
-	return "Unknow method"
+	return gm.localize(l, message, nil)
 }
 
-func (gm *GameManager) getGameMetadata(game *chess.Game) (string, string, *model.User, *model.User) {
-	id := game.GetTagPair(channelTag).Value
-	postID := ""
-	whiteID := game.GetTagPair(whiteTag).Value
-	blackID := game.GetTagPair(blackTag).Value
+// colorName returns the English name of the color, as used in the board image URLs.
+func colorName(c chess.Color) string {
+	if c == chess.Black {
+		return "Black"
+	}
+	return "White"
+}
 
-	postPair := game.GetTagPair(postTag)
-	if postPair != nil {
-		postID = postPair.Value
+// localizeColor returns the name of the color.
+func (gm *GameManager) localizeColor(l *i18n.Localizer, c chess.Color) string {
+	if c == chess.Black {
+		return gm.localize(l, &i18n.Message{ID: "chess.color.black", Other: "Black"}, nil)
 	}
+	return gm.localize(l, &i18n.Message{ID: "chess.color.white", Other: "White"}, nil)
+}
 
-	whiteUser, appErr := gm.api.GetUser(whiteID)
+// getPlayers returns the white and black users. Users that cannot be fetched are
+// returned as placeholders, so a missing account does not break the game.
+func (gm *GameManager) getPlayers(game *Game) (*model.User, *model.User) {
+	whiteUser, appErr := gm.api.GetUser(game.WhiteID)
 	if appErr != nil {
-		return "", "", nil, nil
+		whiteUser = &model.User{Id: game.WhiteID, Username: "unknown"}
 	}
-	blackUser, appErr := gm.api.GetUser(blackID)
+	blackUser, appErr := gm.api.GetUser(game.BlackID)
 	if appErr != nil {
-		return "", "", nil, nil
+		blackUser = &model.User{Id: game.BlackID, Username: "unknown"}
 	}
 
-	return id, postID, whiteUser, blackUser
+	return whiteUser, blackUser
 }
 
 func (gm *GameManager) CanMove(id, player string) bool {
-	g := gm.getGame(id)
-	if g == nil {
+	g, err := gm.getGame(id)
+	if err != nil {
 		return false
 	}
 
-	_, _, whitePlayer, blackPlayer := gm.getGameMetadata(g)
-
-	turn := whitePlayer
-	if len(g.Moves())%2 == 1 {
-		turn = blackPlayer
-	}
-
-	return turn.Id == player
+	return !g.IsOver() && g.PlayerToMove() == player
 }
 
 func (gm *GameManager) IsPlayingGame(id, player string) bool {
-	g := gm.getGame(id)
-	if g == nil {
+	g, err := gm.getGame(id)
+	if err != nil {
 		return false
 	}
 
-	_, _, whitePlayer, blackPlayer := gm.getGameMetadata(g)
-
-	return whitePlayer.Id == player || blackPlayer.Id == player
+	return g.IsPlayer(player)
 }
 
-func (gm *GameManager) PrintImage(w http.ResponseWriter, fen, from, to, check, capture string) {
-	gf, err := chess.FEN(fen)
+// IsGamePost reports whether the post is the shared post of the game.
+func (gm *GameManager) IsGamePost(id, postID string) bool {
+	g, err := gm.getGame(id)
 	if err != nil {
-		return
+		return false
 	}
-	g := chess.NewGame(gf)
-
-	w.Header().Set("Content-Type", "image/svg+xml")
-	buf := bytes.NewBuffer([]byte{})
-	if from == "" {
-		_ = chessImage.SVG(buf, g.Position().Board())
-	} else {
-		cian := color.RGBA{0, 255, 255, 1}
-		red := color.RGBA{255, 0, 0, 1}
-
-		redSquares := []chess.Square{}
-		if capture != "" {
-			redSquares = append(redSquares, strToSquareMap[capture])
-		}
-		if check != "" {
-			redSquares = append(redSquares, strToSquareMap[check])
-		}
 
-		_ = chessImage.SVG(
-			buf,
-			g.Position().Board(),
-			chessImage.MarkSquares(cian, strToSquareMap[from], strToSquareMap[to]),
-			chessImage.MarkSquares(red, redSquares...),
-		)
-	}
-
-	// Minor fixes for mobile strictness
-	svgstring := string(buf.Bytes())
-	// Add viewbox
-	svgstrings := strings.Split(svgstring, "\n")
-	r, _ := regexp.Compile("([a-z]+)=\"([0-9]+)\"")
-	match := r.FindAllStringSubmatch(svgstrings[2], -1)
-	svgstrings[2] += fmt.Sprintf(` viewBox="0 0 %s %s"`, match[0][2], match[1][2])
-	svgstring = strings.Join(svgstrings, "\n")
-	// Fix badly formed color fill
-	r, _ = regexp.Compile(":000000")
-	out := r.ReplaceAll([]byte(svgstring), []byte(":#000000"))
-	w.Write([]byte(out))
+	return g.PostID == postID
 }
 
-var (
-	pieceToPieceName = map[chess.PieceType]string{
-		chess.King:   "King",
-		chess.Queen:  "Queen",
-		chess.Bishop: "Bishop",
-		chess.Knight: "Knight",
-		chess.Rook:   "Rook",
-		chess.Pawn:   "Pawn",
-	}
-	strToSquareMap = map[string]chess.Square{
-		"a1": chess.A1, "a2": chess.A2, "a3": chess.A3, "a4": chess.A4, "a5": chess.A5, "a6": chess.A6, "a7": chess.A7, "a8": chess.A8,
-		"b1": chess.B1, "b2": chess.B2, "b3": chess.B3, "b4": chess.B4, "b5": chess.B5, "b6": chess.B6, "b7": chess.B7, "b8": chess.B8,
-		"c1": chess.C1, "c2": chess.C2, "c3": chess.C3, "c4": chess.C4, "c5": chess.C5, "c6": chess.C6, "c7": chess.C7, "c8": chess.C8,
-		"d1": chess.D1, "d2": chess.D2, "d3": chess.D3, "d4": chess.D4, "d5": chess.D5, "d6": chess.D6, "d7": chess.D7, "d8": chess.D8,
-		"e1": chess.E1, "e2": chess.E2, "e3": chess.E3, "e4": chess.E4, "e5": chess.E5, "e6": chess.E6, "e7": chess.E7, "e8": chess.E8,
-		"f1": chess.F1, "f2": chess.F2, "f3": chess.F3, "f4": chess.F4, "f5": chess.F5, "f6": chess.F6, "f7": chess.F7, "f8": chess.F8,
-		"g1": chess.G1, "g2": chess.G2, "g3": chess.G3, "g4": chess.G4, "g5": chess.G5, "g6": chess.G6, "g7": chess.G7, "g8": chess.G8,
-		"h1": chess.H1, "h2": chess.H2, "h3": chess.H3, "h4": chess.H4, "h5": chess.H5, "h6": chess.H6, "h7": chess.H7, "h8": chess.H8,
-	}
-)
+// localizePiece returns the name of the piece type.
+func (gm *GameManager) localizePiece(l *i18n.Localizer, pieceType chess.PieceType) string {
+	message := &i18n.Message{ID: "chess.piece.pawn", Other: "Pawn"}
+	switch pieceType {
+	case chess.King:
+		message = &i18n.Message{ID: "chess.piece.king", Other: "King"}
+	case chess.Queen:
+		message = &i18n.Message{ID: "chess.piece.queen", Other: "Queen"}
+	case chess.Rook:
+		message = &i18n.Message{ID: "chess.piece.rook", Other: "Rook"}
+	case chess.Bishop:
+		message = &i18n.Message{ID: "chess.piece.bishop", Other: "Bishop"}
+	case chess.Knight:
+		message = &i18n.Message{ID: "chess.piece.knight", Other: "Knight"}
+	}
+	return gm.localize(l, message, nil)
+}
@@ -0,0 +1,286 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/mattermost/mattermost-plugin-api/i18n"
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/plugin/plugintest"
+	"github.com/mattermost/mattermost-server/v5/plugin/plugintest/mock"
+	"github.com/notnil/chess"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupTestAPI returns a plugin API mock answering the calls made while playing a
+// game. The calls specific to a test are added by the test itself.
+func setupTestAPI(t *testing.T) *plugintest.API {
+	api := &plugintest.API{}
+	t.Cleanup(func() { api.AssertExpectations(t) })
+
+	config := &model.Config{}
+	config.SetDefaults()
+	*config.ServiceSettings.SiteURL = "http://localhost"
+
+	api.On("GetBundlePath").Return("..", nil).Maybe()
+	api.On("GetConfig").Return(config).Maybe()
+	api.On("GetUser", mock.AnythingOfType("string")).Return(func(id string) *model.User {
+		return &model.User{Id: id, Username: "user-" + id[:4], Locale: "en"}
+	}, nil).Maybe()
+	api.On("GetDirectChannel", mock.Anything, mock.Anything).Return(&model.Channel{Id: model.NewId()}, nil).Maybe()
+	api.On("CreatePost", mock.Anything).Return(&model.Post{Id: model.NewId()}, nil).Maybe()
+	// Cluster mutexes are taken, refreshed and released through KVSetWithOptions.
+	api.On("KVSetWithOptions", mock.Anything, mock.Anything, mock.Anything).Return(true, nil).Maybe()
+	for _, level := range []string{"LogDebug", "LogWarn", "LogError"} {
+		for pairs := 0; pairs <= 3; pairs++ {
+			args := make([]interface{}, 1+2*pairs)
+			for i := range args {
+				args[i] = mock.Anything
+			}
+			api.On(level, args...).Maybe()
+		}
+	}
+
+	return api
+}
+
+// setupTestGameManager returns a game manager keeping its games in memory.
+func setupTestGameManager(t *testing.T, api *plugintest.API) (*GameManager, *MemoryGameStore) {
+	require.NoError(t, loadECOPositions())
+
+	store := NewMemoryGameStore()
+	gm := NewGameManager(api, store, model.NewId(), func(string, string) {}, func() *configuration { return &configuration{} })
+
+	var err error
+	gm.bundle, err = i18n.InitBundle(api, i18nPath)
+	require.NoError(t, err)
+
+	return &gm, store
+}
+
+// startTestGame stores a new game in progress after playing moves.
+func startTestGame(t *testing.T, gm *GameManager, moves ...string) *Game {
+	game := &Game{
+		Game: chess.NewGame(),
+		GameMetadata: GameMetadata{
+			ID:        model.NewId(),
+			ChannelID: model.NewId(),
+			PostID:    model.NewId(),
+			WhiteID:   model.NewId(),
+			BlackID:   model.NewId(),
+			Variant:   variantStandard,
+		},
+	}
+	for _, move := range moves {
+		require.NoError(t, game.MoveStr(move))
+	}
+
+	require.NoError(t, gm.saveGame(game))
+	require.NoError(t, gm.store.SetChannelGameID(game.ChannelID, game.ID))
+	gm.addActiveGame(game)
+	return game
+}
+
+func TestMove(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		played      []string
+		byBlack     bool
+		move        string
+		expectError bool
+		outcome     chess.Outcome
+	}{
+		{name: "first move", move: "e4", outcome: chess.NoOutcome},
+		{name: "reply", played: []string{"e4"}, byBlack: true, move: "e5", outcome: chess.NoOutcome},
+		{name: "not your turn", byBlack: true, move: "e5", expectError: true},
+		{name: "invalid move", move: "e5", expectError: true},
+		{name: "checkmate", played: []string{"f3", "e5", "g4"}, byBlack: true, move: "Qh4", outcome: chess.BlackWon},
+		{name: "game over", played: []string{"f3", "e5", "g4", "Qh4"}, move: "e4", expectError: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			api := setupTestAPI(t)
+			gm, store := setupTestGameManager(t, api)
+			game := startTestGame(t, gm, tc.played...)
+
+			player := game.WhiteID
+			if tc.byBlack {
+				player = game.BlackID
+			}
+			_, err := gm.Move(game.ID, player, tc.move)
+			if tc.expectError {
+				require.Error(t, err)
+				stored, getErr := store.GetGame(game.ID)
+				require.NoError(t, getErr)
+				assert.Len(t, stored.Moves(), len(tc.played))
+				return
+			}
+			require.NoError(t, err)
+
+			stored, err := store.GetGame(game.ID)
+			require.NoError(t, err)
+			assert.Len(t, stored.Moves(), len(tc.played)+1)
+			assert.Equal(t, tc.outcome, stored.Outcome())
+			require.Len(t, stored.Events, 1)
+			assert.Equal(t, player, stored.Events[0].UserID)
+
+			active, err := store.GetIndex(allActiveGamesIndex)
+			require.NoError(t, err)
+			assert.Equal(t, tc.outcome == chess.NoOutcome, containsString(active, game.ID))
+			assert.Equal(t, tc.outcome != chess.NoOutcome, gm.isArchived(game.ID))
+		})
+	}
+}
+
+func TestResign(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		played      []string
+		byBlack     bool
+		notPlaying  bool
+		expectError bool
+		outcome     chess.Outcome
+	}{
+		{name: "white resigns", played: []string{"e4"}, outcome: chess.BlackWon},
+		{name: "black resigns", played: []string{"e4"}, byBlack: true, outcome: chess.WhiteWon},
+		{name: "before the first move", outcome: chess.BlackWon},
+		{name: "not playing", notPlaying: true, expectError: true},
+		{name: "game over", played: []string{"f3", "e5", "g4", "Qh4"}, byBlack: true, expectError: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			api := setupTestAPI(t)
+			gm, store := setupTestGameManager(t, api)
+			game := startTestGame(t, gm, tc.played...)
+
+			player := game.WhiteID
+			switch {
+			case tc.byBlack:
+				player = game.BlackID
+			case tc.notPlaying:
+				player = model.NewId()
+			}
+			_, err := gm.Resign(game.ID, player)
+			if tc.expectError {
+				require.Error(t, err)
+				assert.False(t, gm.isArchived(game.ID))
+				return
+			}
+			require.NoError(t, err)
+
+			stored, err := store.GetGame(game.ID)
+			require.NoError(t, err)
+			assert.Equal(t, tc.outcome, stored.Outcome())
+			assert.Equal(t, chess.Resignation, stored.Method())
+			assert.True(t, gm.isArchived(game.ID))
+		})
+	}
+}
+
+func TestAbort(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		played      []string
+		byBlack     bool
+		notPlaying  bool
+		expectError bool
+	}{
+		{name: "before the first move", byBlack: true},
+		{name: "after the first move", played: []string{"e4"}},
+		{name: "by the player who moved", played: []string{"e4"}, byBlack: true},
+		{name: "after both sides moved", played: []string{"e4", "e5"}, expectError: true},
+		{name: "not playing", notPlaying: true, expectError: true},
+		{name: "game over", played: []string{"f3", "e5", "g4", "Qh4"}, expectError: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			api := setupTestAPI(t)
+			gm, store := setupTestGameManager(t, api)
+			game := startTestGame(t, gm, tc.played...)
+
+			player := game.WhiteID
+			switch {
+			case tc.byBlack:
+				player = game.BlackID
+			case tc.notPlaying:
+				player = model.NewId()
+			}
+			_, err := gm.Abort(game.ID, player)
+			if tc.expectError {
+				require.Error(t, err)
+				assert.False(t, gm.isArchived(game.ID))
+				return
+			}
+			require.NoError(t, err)
+
+			stored, err := store.GetGame(game.ID)
+			require.NoError(t, err)
+			assert.True(t, stored.IsOver())
+			assert.Equal(t, chess.NoOutcome, stored.Outcome())
+			assert.Equal(t, terminationAborted, stored.Termination)
+			assert.True(t, gm.isArchived(game.ID))
+		})
+	}
+}
+
+func TestFinishGame(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		moves       []string
+		termination string
+		outcome     chess.Outcome
+		method      chess.Method
+		opening     string
+	}{
+		{
+			name:    "checkmate",
+			moves:   []string{"e4", "e5", "Bc4", "Nc6", "Qh5", "Nf6", "Qxf7"},
+			outcome: chess.WhiteWon,
+			method:  chess.Checkmate,
+			opening: "C23 Bishop's Opening",
+		},
+		{
+			name:        "aborted",
+			moves:       []string{"d4"},
+			termination: terminationAborted,
+			outcome:     chess.NoOutcome,
+			method:      chess.NoMethod,
+			opening:     "A40 Queen's Pawn Game",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			api := setupTestAPI(t)
+			gm, store := setupTestGameManager(t, api)
+			game := startTestGame(t, gm)
+			for _, move := range tc.moves {
+				require.NoError(t, game.MoveStr(move))
+				game.classifyOpening()
+			}
+			game.Termination = tc.termination
+
+			require.NoError(t, gm.finishGame(game))
+
+			archived, err := store.GetArchivedGame(game.ID)
+			require.NoError(t, err)
+			assert.Equal(t, game.WhiteID, archived.WhiteID)
+			assert.Equal(t, game.BlackID, archived.BlackID)
+			assert.Equal(t, tc.outcome, archived.Outcome)
+			assert.Equal(t, tc.method, archived.Method)
+			assert.Equal(t, tc.termination, archived.Termination)
+			assert.Equal(t, len(tc.moves), archived.Plies)
+			assert.Equal(t, tc.opening, archived.Opening)
+
+			for _, userID := range []string{game.WhiteID, game.BlackID} {
+				games, err := gm.GetArchivedGames(userID)
+				require.NoError(t, err)
+				require.Len(t, games, 1)
+				assert.Equal(t, game.ID, games[0].ID)
+
+				active, err := gm.GetActiveGames(userID)
+				require.NoError(t, err)
+				assert.Empty(t, active)
+			}
+
+			stored, err := store.GetGame(game.ID)
+			require.NoError(t, err)
+			assert.True(t, stored.IsOver())
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mattermost/mattermost-plugin-api/i18n"
+	"github.com/notnil/chess"
+)
+
+// recentMovesShown is how many half moves the game post lists. Older moves are
+// left out so the post fits on mobile screens.
+const recentMovesShown = 8
+
+var (
+	initialPieceCounts = map[chess.PieceType]int{
+		chess.Queen:  1,
+		chess.Rook:   2,
+		chess.Bishop: 2,
+		chess.Knight: 2,
+		chess.Pawn:   8,
+	}
+
+	pieceValues = map[chess.PieceType]int{
+		chess.Queen:  9,
+		chess.Rook:   5,
+		chess.Bishop: 3,
+		chess.Knight: 3,
+		chess.Pawn:   1,
+	}
+)
+
+// recentMoves returns the last moves of the game in SAN with their numbers, e.g.
+// "12... Nc6 13. Bb5 a6", and whether older moves were left out.
+func recentMoves(game *chess.Game, count int) (string, bool) {
+	moves := game.Moves()
+	positions := game.Positions()
+
+	start := 0
+	if len(moves) > count {
+		start = len(moves) - count
+	}
+
+	sb := &strings.Builder{}
+	for i := start; i < len(moves); i++ {
+		if sb.Len() > 0 {
+			sb.WriteString(" ")
+		}
+		switch {
+		case i%2 == 0:
+			fmt.Fprintf(sb, "%d. ", i/2+1)
+		case i == start:
+			fmt.Fprintf(sb, "%d... ", i/2+1)
+		}
+		sb.WriteString(chess.AlgebraicNotation{}.Encode(positions[i], moves[i]))
+	}
+
+	return sb.String(), start > 0
+}
+
+// capturedPieces returns the pieces of the color that are no longer on the board,
+// the most valuable first. Promoted pawns are not counted as captured.
+func capturedPieces(board *chess.Board, c chess.Color) []chess.Piece {
+	counts := map[chess.PieceType]int{}
+	for _, piece := range board.SquareMap() {
+		if piece.Color() == c {
+			counts[piece.Type()]++
+		}
+	}
+
+	captured := []chess.Piece{}
+	promotions := 0
+	for _, pieceType := range []chess.PieceType{chess.Queen, chess.Rook, chess.Bishop, chess.Knight} {
+		initial := initialPieceCounts[pieceType]
+		if counts[pieceType] > initial {
+			promotions += counts[pieceType] - initial
+		}
+		for i := counts[pieceType]; i < initial; i++ {
+			captured = append(captured, pieceFor(c, pieceType))
+		}
+	}
+	for i := counts[chess.Pawn] + promotions; i < initialPieceCounts[chess.Pawn]; i++ {
+		captured = append(captured, pieceFor(c, chess.Pawn))
+	}
+
+	return captured
+}
+
+// materialBalance returns the value of the White pieces on the board minus the
+// value of the Black ones, in pawns.
+func materialBalance(board *chess.Board) int {
+	balance := 0
+	for _, piece := range board.SquareMap() {
+		if piece.Color() == chess.White {
+			balance += pieceValues[piece.Type()]
+		} else {
+			balance -= pieceValues[piece.Type()]
+		}
+	}
+	return balance
+}
+
+// formatCaptures returns the pieces captured by the color as figurines, followed by
+// the material advantage of the color, if any.
+func (gm *GameManager) formatCaptures(l *i18n.Localizer, board *chess.Board, c chess.Color) string {
+	sb := &strings.Builder{}
+	for _, piece := range capturedPieces(board, c.Other()) {
+		sb.WriteString(piece.String())
+	}
+	if sb.Len() == 0 {
+		sb.WriteString(gm.localize(l, &i18n.Message{
+			ID:    "chess.game.no_captures",
+			Other: "none",
+		}, nil))
+	}
+
+	advantage := materialBalance(board)
+	if c == chess.Black {
+		advantage = -advantage
+	}
+	if advantage > 0 {
+		fmt.Fprintf(sb, " (+%d)", advantage)
+	}
+	return sb.String()
+}
+
+func pieceFor(c chess.Color, pieceType chess.PieceType) chess.Piece {
+	for _, piece := range []chess.Piece{
+		chess.WhiteQueen, chess.WhiteRook, chess.WhiteBishop, chess.WhiteKnight, chess.WhitePawn,
+		chess.BlackQueen, chess.BlackRook, chess.BlackBishop, chess.BlackKnight, chess.BlackPawn,
+	} {
+		if piece.Color() == c && piece.Type() == pieceType {
+			return piece
+		}
+	}
+	return chess.NoPiece
+}
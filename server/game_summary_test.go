@@ -0,0 +1,61 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/notnil/chess"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatCaptures(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		fen   string
+		white string
+		black string
+	}{
+		{
+			name:  "starting position",
+			fen:   chess.StartingPosition().String(),
+			white: "none",
+			black: "none",
+		},
+		{
+			name:  "pawn captured",
+			fen:   "rnbqkbnr/ppp1pppp/8/3P4/8/8/PPPP1PPP/RNBQKBNR b KQkq - 0 2",
+			white: "♟ (+1)",
+			black: "none",
+		},
+		{
+			name:  "traded pieces",
+			fen:   "r1bqkb1r/pppppppp/8/8/8/8/PPPPPPPP/R1BQKB1R w KQkq - 0 1",
+			white: "♞♞",
+			black: "♘♘",
+		},
+		{
+			name:  "promoted pawn is not captured",
+			fen:   "rnb1kbnr/pppppppp/8/8/8/8/PPPPPPP1/RNBQKBNQ w - - 0 1",
+			white: "♛ (+12)",
+			black: "♖",
+		},
+		{
+			name:  "most valuable first",
+			fen:   "4k3/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQ - 0 1",
+			white: "♛♜♜♝♝♞♞ (+31)",
+			black: "none",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			api := setupTestAPI(t)
+			gm, _ := setupTestGameManager(t, api)
+			fen, err := chess.FEN(tc.fen)
+			require.NoError(t, err)
+			board := chess.NewGame(fen).Position().Board()
+
+			l := gm.serverLocalizer()
+			assert.Equal(t, tc.white, gm.formatCaptures(l, board, chess.White))
+			assert.Equal(t, tc.black, gm.formatCaptures(l, board, chess.Black))
+		})
+	}
+}
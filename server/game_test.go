@@ -0,0 +1,135 @@
+package main
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/notnil/chess"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// playTestGame plays the moves, recording them in the event log.
+func playTestGame(t *testing.T, moves ...string) *Game {
+	game := &Game{
+		Game: chess.NewGame(),
+		GameMetadata: GameMetadata{
+			ID:          model.NewId(),
+			ChannelID:   model.NewId(),
+			PostID:      model.NewId(),
+			WhiteID:     model.NewId(),
+			BlackID:     model.NewId(),
+			Variant:     variantStandard,
+			TimeControl: "5+3",
+			CreatedAt:   1000,
+			LastMoveAt:  2000,
+			MoveLog:     true,
+		},
+	}
+	for i, move := range moves {
+		require.NoError(t, game.MoveStr(move))
+		game.recordLastMove(game.WaitingPlayer(), int64(1000*i))
+	}
+	return game
+}
+
+func TestDecodeGameRecord(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		game *Game
+		// edit changes the encoded record before decoding it.
+		edit        func(record *gameRecord)
+		expectError bool
+	}{
+		{
+			name: "new game",
+			game: playTestGame(t),
+		},
+		{
+			name: "game in progress",
+			game: playTestGame(t, "e4", "c5", "Nf3", "d6"),
+		},
+		{
+			name: "checkmate",
+			game: playTestGame(t, "f3", "e5", "g4", "Qh4"),
+		},
+		{
+			name: "resignation keeps the method",
+			game: func() *Game {
+				game := playTestGame(t, "d4", "d5")
+				game.Resign(chess.White)
+				return game
+			}(),
+		},
+		{
+			name: "aborted",
+			game: func() *Game {
+				game := playTestGame(t, "e4")
+				game.Termination = terminationAborted
+				return game
+			}(),
+		},
+		{
+			name: "unreadable PGN is rebuilt from the events",
+			game: playTestGame(t, "e4", "e5", "Nf3", "Nc6"),
+			edit: func(record *gameRecord) {
+				record.PGN = "1. e4 e5 2. Nf3 Nc6 3. Qxh8 *"
+			},
+		},
+		{
+			name: "unreadable PGN without events",
+			game: playTestGame(t),
+			edit: func(record *gameRecord) {
+				record.PGN = "1. e4 e5 2. Nf3 Nc6 3. Qxh8 *"
+			},
+			expectError: true,
+		},
+		{
+			name: "events that do not match the positions",
+			game: playTestGame(t, "e4", "e5"),
+			edit: func(record *gameRecord) {
+				record.PGN = "1. e4 Qxh8 *"
+				record.Events[1].FEN = chess.StartingPosition().String()
+			},
+			expectError: true,
+		},
+		{
+			name: "unknown version",
+			game: playTestGame(t, "e4"),
+			edit: func(record *gameRecord) {
+				record.Version = gameRecordVersion + 1
+			},
+			expectError: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			b, err := encodeGameRecord(tc.game)
+			require.NoError(t, err)
+
+			if tc.edit != nil {
+				record := gameRecord{}
+				require.NoError(t, json.Unmarshal(b, &record))
+				tc.edit(&record)
+				b, err = json.Marshal(record)
+				require.NoError(t, err)
+			}
+
+			decoded, err := decodeGameRecord(b)
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			expectedMetadata := tc.game.GameMetadata
+			expectedMetadata.EndMethod = tc.game.Method()
+			assert.Equal(t, expectedMetadata, decoded.GameMetadata)
+			assert.Equal(t, tc.game.FEN(), decoded.FEN())
+			assert.Equal(t, tc.game.Outcome(), decoded.Outcome())
+			assert.Equal(t, tc.game.Method(), decoded.Method())
+			assert.Equal(t, tc.game.IsOver(), decoded.IsOver())
+			assert.Len(t, decoded.Moves(), len(tc.game.Moves()))
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"strings"
+	"text/template"
+
+	"github.com/mattermost/mattermost-plugin-api/i18n"
+	"github.com/pkg/errors"
+)
+
+// i18nPath is the folder of the message catalogues in the plugin bundle. English
+// messages are written in the code, so there is no catalogue for them.
+const i18nPath = "assets/i18n"
+
+// userLocalizer localizes the messages shown only to the user, in their language.
+func (gm *GameManager) userLocalizer(userID string) *i18n.Localizer {
+	return gm.bundle.GetUserLocalizer(userID)
+}
+
+// serverLocalizer localizes the messages of the posts shared by everyone, in the
+// default language of the server.
+func (gm *GameManager) serverLocalizer() *i18n.Localizer {
+	return gm.bundle.GetServerLocalizer()
+}
+
+// localize returns the message in the language of the localizer, filled with data.
+func (gm *GameManager) localize(l *i18n.Localizer, message *i18n.Message, data map[string]interface{}) string {
+	return gm.bundle.LocalizeWithConfig(l, &i18n.LocalizeConfig{
+		DefaultMessage: message,
+		TemplateData:   data,
+	})
+}
+
+// localizePlural returns the plural form of the message for count, which is also
+// passed to the message as Count.
+func (gm *GameManager) localizePlural(l *i18n.Localizer, message *i18n.Message, count interface{}, data map[string]interface{}) string {
+	if data == nil {
+		data = map[string]interface{}{}
+	}
+	data["Count"] = count
+	return gm.bundle.LocalizeWithConfig(l, &i18n.LocalizeConfig{
+		DefaultMessage: message,
+		TemplateData:   data,
+		PluralCount:    count,
+	})
+}
+
+// userError is an error caused by the user, whose message is shown to them in
+// their language.
+type userError struct {
+	message *i18n.Message
+	data    map[string]interface{}
+}
+
+func newUserError(message *i18n.Message, data map[string]interface{}) error {
+	return &userError{message: message, data: data}
+}
+
+// Error returns the message in English, for the logs.
+func (e *userError) Error() string {
+	t, err := template.New(e.message.ID).Parse(e.message.Other)
+	if err != nil {
+		return e.message.Other
+	}
+
+	sb := &strings.Builder{}
+	if err := t.Execute(sb, e.data); err != nil {
+		return e.message.Other
+	}
+	return sb.String()
+}
+
+// localizeError returns the message of the error in the language of the localizer.
+// Only user errors are translated.
+func (gm *GameManager) localizeError(l *i18n.Localizer, err error) string {
+	if userErr, ok := errors.Cause(err).(*userError); ok {
+		return gm.localize(l, userErr.message, userErr.data)
+	}
+	return err.Error()
+}
+
+// Messages of the errors returned in several places.
+var (
+	messageBlindfoldBoard = &i18n.Message{
+		ID:    "chess.error.blindfold_board",
+		Other: "the board is hidden during blindfold games",
+	}
+	messageGameOver = &i18n.Message{
+		ID:    "chess.error.game_over",
+		Other: "the game is over",
+	}
+	messageNotPlaying = &i18n.Message{
+		ID:    "chess.error.not_playing",
+		Other: "you are not playing this game",
+	}
+	messageCannotSee = &i18n.Message{
+		ID:    "chess.error.cannot_see",
+		Other: "you cannot see this game",
+	}
+)
+
+// Messages shared by the game post, the move log and the views of a single user.
+var (
+	messageGameTitle = &i18n.Message{
+		ID:    "chess.game.title",
+		Other: "Chess game",
+	}
+	messagePlayers = &i18n.Message{
+		ID:    "chess.game.players",
+		Other: "White: {{.White}}\nBlack: {{.Black}}",
+	}
+	messageGoToBoard = &i18n.Message{
+		ID:    "chess.game.go_to_board",
+		Other: "[Go to the board]({{.URL}})",
+	}
+	messageTurn = &i18n.Message{
+		ID:    "chess.game.turn",
+		Other: "Turn: {{.Color}}",
+	}
+	messagePromotion = &i18n.Message{
+		ID:    "chess.move.promotion",
+		Other: "Pawn promoted to {{.Piece}}",
+	}
+	messageAbandonment = &i18n.Message{
+		ID:    "chess.method.abandonment",
+		Other: "abandonment",
+	}
+	messageCheck = &i18n.Message{
+		ID:    "chess.move.check",
+		Other: "CHECK!",
+	}
+)
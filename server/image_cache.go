@@ -0,0 +1,79 @@
+package main
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+)
+
+// imageCacheSize is the number of rendered board images kept in memory.
+const imageCacheSize = 256
+
+// renderedImage is a board image ready to be served.
+type renderedImage struct {
+	ContentType string
+	Body        []byte
+	ETag        string
+}
+
+func newRenderedImage(contentType string, body []byte) *renderedImage {
+	sum := sha256.Sum256(body)
+	return &renderedImage{
+		ContentType: contentType,
+		Body:        body,
+		ETag:        `"` + hex.EncodeToString(sum[:16]) + `"`,
+	}
+}
+
+// imageCache is a least recently used cache of rendered board images.
+type imageCache struct {
+	lock    sync.Mutex
+	size    int
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+type imageCacheEntry struct {
+	key   string
+	image *renderedImage
+}
+
+func newImageCache(size int) *imageCache {
+	return &imageCache{
+		size:    size,
+		order:   list.New(),
+		entries: map[string]*list.Element{},
+	}
+}
+
+func (c *imageCache) get(key string) (*renderedImage, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	element, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	c.order.MoveToFront(element)
+	return element.Value.(*imageCacheEntry).image, true
+}
+
+func (c *imageCache) add(key string, image *renderedImage) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if element, ok := c.entries[key]; ok {
+		element.Value.(*imageCacheEntry).image = image
+		c.order.MoveToFront(element)
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(&imageCacheEntry{key: key, image: image})
+	for c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*imageCacheEntry).key)
+	}
+}
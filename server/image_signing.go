@@ -0,0 +1,80 @@
+package main
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+
+	"github.com/mattermost/mattermost-server/v5/plugin"
+	"github.com/pkg/errors"
+)
+
+const (
+	imageSigningKeyKey  = "image_signing_key"
+	imageSigningKeySize = 32
+
+	imageSignatureParam = "sig"
+)
+
+// ensureImageSigningKey returns the key board image URLs are signed with, creating
+// it the first time. The key is shared by every node of the cluster.
+func ensureImageSigningKey(api plugin.API) ([]byte, error) {
+	key, appErr := api.KVGet(imageSigningKeyKey)
+	if appErr != nil {
+		return nil, appErr
+	}
+	if len(key) > 0 {
+		return key, nil
+	}
+
+	key = make([]byte, imageSigningKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, errors.Wrap(err, "failed to generate the image signing key")
+	}
+
+	ok, appErr := api.KVCompareAndSet(imageSigningKeyKey, nil, key)
+	if appErr != nil {
+		return nil, appErr
+	}
+	if ok {
+		return key, nil
+	}
+
+	// Another node created the key first.
+	key, appErr = api.KVGet(imageSigningKeyKey)
+	if appErr != nil {
+		return nil, appErr
+	}
+	if len(key) == 0 {
+		return nil, errors.New("failed to get the image signing key")
+	}
+	return key, nil
+}
+
+// signImage returns the signature of the image with the given format and query,
+// ignoring any signature already in the query.
+func signImage(key []byte, format string, query url.Values) string {
+	unsigned := url.Values{}
+	for k, v := range query {
+		if k != imageSignatureParam {
+			unsigned[k] = v
+		}
+	}
+
+	mac := hmac.New(sha256.New, key)
+	_, _ = mac.Write([]byte(format + "?" + unsigned.Encode()))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// verifyImage reports whether the query holds a valid signature for the image.
+func verifyImage(key []byte, format string, query url.Values) bool {
+	signature, err := hex.DecodeString(query.Get(imageSignatureParam))
+	if err != nil || len(signature) == 0 {
+		return false
+	}
+
+	expected, _ := hex.DecodeString(signImage(key, format, query))
+	return hmac.Equal(signature, expected)
+}
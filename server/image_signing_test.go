@@ -0,0 +1,73 @@
+package main
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/notnil/chess"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyImage(t *testing.T) {
+	key := []byte("0123456789abcdef0123456789abcdef")
+	afterE4 := &BoardImage{FEN: "rnbqkbnr/pppppppp/8/8/4P3/8/PPPP1PPP/RNBQKBNR b KQkq - 0 1", From: "e2", To: "e4", Format: boardImageSVG}
+
+	for _, tc := range []struct {
+		name string
+		// img is the signed image, afterE4 if nil.
+		img *BoardImage
+		// format is the format the image is requested in, the signed one if empty.
+		format string
+		// edit changes the signed query before verifying it.
+		edit func(query url.Values)
+		// key verifies the signature instead of the signing key.
+		key   []byte
+		valid bool
+	}{
+		{name: "signed", valid: true},
+		{
+			name: "signed annotated board",
+			img: &BoardImage{
+				FEN:    chess.StartingPosition().String(),
+				Format: boardImagePNG,
+				Size:   400,
+				Arrows: []BoardArrow{{From: chess.E2, To: chess.E4, Color: annotationColors["green"]}},
+				Marks:  []SquareMark{{Square: chess.F7, Color: annotationColors["red"]}},
+			},
+			valid: true,
+		},
+		{name: "unsigned", edit: func(query url.Values) { query.Del(imageSignatureParam) }},
+		{name: "signature that is not hex", edit: func(query url.Values) { query.Set(imageSignatureParam, "not hex") }},
+		{name: "changed board", edit: func(query url.Values) { query.Set("fen", chess.StartingPosition().String()) }},
+		{name: "added parameter", edit: func(query url.Values) { query.Set("theme", "green") }},
+		{name: "other format", format: boardImagePNG},
+		{name: "other key", key: []byte("fedcba9876543210fedcba9876543210")},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			img := afterE4
+			if tc.img != nil {
+				img = tc.img
+			}
+			signed := img.query()
+			signed.Set(imageSignatureParam, signImage(key, img.Format, signed))
+
+			// The query reaches the server through the image URL.
+			query, err := url.ParseQuery(signed.Encode())
+			require.NoError(t, err)
+			if tc.edit != nil {
+				tc.edit(query)
+			}
+
+			format := img.Format
+			if tc.format != "" {
+				format = tc.format
+			}
+			verifyKey := key
+			if tc.key != nil {
+				verifyKey = tc.key
+			}
+			assert.Equal(t, tc.valid, verifyImage(verifyKey, format, query))
+		})
+	}
+}
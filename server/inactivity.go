@@ -0,0 +1,262 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/mattermost/mattermost-plugin-api/i18n"
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/notnil/chess"
+)
+
+const inactivityCheckInterval = 15 * time.Minute
+
+// checkInactiveGames runs periodically on a single node of the cluster.
+func (p *Plugin) checkInactiveGames() {
+	config := p.getConfiguration()
+	p.gameManager.CheckInactiveGames(config.reminderAfter(), config.abandonmentAfter())
+}
+
+// CheckInactiveGames nudges the players that have not moved for remindAfter and,
+// once abandonAfter has passed, offers their opponents to claim the win or abort the game.
+// A zero duration disables the corresponding step.
+func (gm *GameManager) CheckInactiveGames(remindAfter, abandonAfter time.Duration) {
+	ids, err := gm.store.GetIndex(allActiveGamesIndex)
+	if err != nil {
+		gm.api.LogError("could not get active games", "error", err.Error())
+		return
+	}
+
+	for _, id := range ids {
+		err = gm.checkInactiveGame(id, remindAfter, abandonAfter)
+		if err != nil {
+			gm.api.LogError("could not check inactive game", "id", id, "error", err.Error())
+		}
+	}
+}
+
+func (gm *GameManager) checkInactiveGame(id string, remindAfter, abandonAfter time.Duration) error {
+	unlock, err := gm.lockGame(id)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	game, err := gm.store.GetGame(id)
+	if err == ErrNotFound || (err == nil && game.IsOver()) {
+		return gm.store.RemoveFromIndex(allActiveGamesIndex, id)
+	}
+	if err != nil {
+		return err
+	}
+
+	idle := idleTime(game)
+	switch {
+	case abandonAfter > 0 && idle >= abandonAfter:
+		if game.ClaimOfferedAt != 0 {
+			return nil
+		}
+		gm.offerAbandonmentClaim(game, idle)
+		game.ClaimOfferedAt = model.GetMillis()
+		return gm.saveGame(game)
+	case remindAfter > 0 && idle >= remindAfter:
+		if game.RemindedAt != 0 {
+			return nil
+		}
+		gm.remindPlayer(game, idle)
+		game.RemindedAt = model.GetMillis()
+		return gm.saveGame(game)
+	}
+
+	return nil
+}
+
+func (gm *GameManager) remindPlayer(game *Game, idle time.Duration) {
+	whiteUser, blackUser := gm.getPlayers(game)
+	player, opponent := whiteUser, blackUser
+	if game.Position().Turn() == chess.Black {
+		player, opponent = blackUser, whiteUser
+	}
+
+	baseURL := gm.api.GetConfig().ServiceSettings.SiteURL
+	l := gm.userLocalizer(player.Id)
+	message := gm.localize(l, &i18n.Message{
+		ID:    "chess.notification.reminder",
+		Other: "@{{.Opponent}} has been waiting for your move for {{.Idle}}.",
+	}, map[string]interface{}{"Opponent": opponent.Username, "Idle": gm.formatIdle(l, idle)})
+	if game.PostID != "" {
+		message += " " + gm.localize(l, messageGoToBoard, map[string]interface{}{"URL": fmt.Sprintf("%s/_redirect/pl/%s", *baseURL, game.PostID)})
+	}
+
+	// Reminders are not turn notifications, so they are sent even when those are off.
+	gm.sendToPlayer(player, gm.GetUserPreferences(player.Id), game.ChannelID, game.PostID, message)
+}
+
+func (gm *GameManager) offerAbandonmentClaim(game *Game, idle time.Duration) {
+	whiteUser, blackUser := gm.getPlayers(game)
+	waiting, absent := blackUser, whiteUser
+	if game.Position().Turn() == chess.Black {
+		waiting, absent = whiteUser, blackUser
+	}
+
+	dm, appErr := gm.api.GetDirectChannel(gm.botID, waiting.Id)
+	if appErr != nil {
+		gm.api.LogError("could not get bot direct channel", "user", waiting.Id, "error", appErr.Error())
+		return
+	}
+
+	baseURL := gm.api.GetConfig().ServiceSettings.SiteURL
+	l := gm.userLocalizer(waiting.Id)
+	text := gm.localize(l, &i18n.Message{
+		ID:    "chess.abandonment.idle",
+		Other: "@{{.Absent}} has not moved for {{.Idle}}.",
+	}, map[string]interface{}{"Absent": absent.Username, "Idle": gm.formatIdle(l, idle)})
+	if game.PostID != "" {
+		text += " " + gm.localize(l, messageGoToBoard, map[string]interface{}{"URL": fmt.Sprintf("%s/_redirect/pl/%s", *baseURL, game.PostID)})
+	}
+	actions := []*model.PostAction{}
+	if game.CanAbort() {
+		// Before both sides have moved there is no game to win, only to abort.
+		text += "\n" + gm.localize(l, &i18n.Message{
+			ID:    "chess.abandonment.options_abort",
+			Other: "You can abort the game or keep waiting.",
+		}, nil)
+	} else {
+		text += "\n" + gm.localize(l, &i18n.Message{
+			ID:    "chess.abandonment.options",
+			Other: "You can claim the win by abandonment, abort the game, or keep waiting.",
+		}, nil)
+		actions = append(actions, &model.PostAction{
+			Type: "button",
+			Name: gm.localize(l, &i18n.Message{
+				ID:    "chess.abandonment.button.claim",
+				Other: "Claim win",
+			}, nil),
+			Integration: &model.PostActionIntegration{
+				URL: fmt.Sprintf("%s/plugins/%s/claim/%s", *baseURL, manifest.Id, game.ID),
+			},
+		})
+	}
+	actions = append(actions, &model.PostAction{
+		Type: "button",
+		Name: gm.localize(l, &i18n.Message{
+			ID:    "chess.abandonment.button.abort",
+			Other: "Abort game",
+		}, nil),
+		Integration: &model.PostActionIntegration{
+			URL: fmt.Sprintf("%s/plugins/%s/abandon/%s", *baseURL, manifest.Id, game.ID),
+		},
+	})
+
+	post := &model.Post{
+		UserId:    gm.botID,
+		ChannelId: dm.Id,
+	}
+	model.ParseSlackAttachment(post, []*model.SlackAttachment{{
+		Title: gm.localize(l, &i18n.Message{
+			ID:    "chess.abandonment.title",
+			Other: "Abandoned game",
+		}, nil),
+		Text:    text,
+		Actions: actions,
+	}})
+
+	_, appErr = gm.api.CreatePost(post)
+	if appErr != nil {
+		gm.api.LogError("could not offer abandonment claim", "user", waiting.Id, "error", appErr.Error())
+	}
+}
+
+// ClaimAbandonment ends the game as won by the waiting player.
+func (gm *GameManager) ClaimAbandonment(id, player string, abandonAfter time.Duration) (*model.Post, error) {
+	game, unlock, err := gm.getLockedGame(id)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	err = checkAbandoned(game, player, abandonAfter)
+	if err != nil {
+		return nil, err
+	}
+
+	if game.CanAbort() {
+		return nil, newUserError(&i18n.Message{
+			ID:    "chess.error.claim_not_started",
+			Other: "the game has not started yet, it can only be aborted",
+		}, nil)
+	}
+
+	game.Resign(game.Position().Turn())
+	game.Termination = terminationAbandoned
+	err = gm.finishGame(game)
+	if err != nil {
+		return nil, err
+	}
+
+	return gm.gameToPost(game), nil
+}
+
+// AbortAbandoned ends the game without a result on request of the waiting player.
+func (gm *GameManager) AbortAbandoned(id, player string, abandonAfter time.Duration) (*model.Post, error) {
+	game, unlock, err := gm.getLockedGame(id)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	err = checkAbandoned(game, player, abandonAfter)
+	if err != nil {
+		return nil, err
+	}
+
+	game.Termination = terminationAborted
+	err = gm.finishGame(game)
+	if err != nil {
+		return nil, err
+	}
+
+	return gm.gameToPost(game), nil
+}
+
+func checkAbandoned(game *Game, player string, abandonAfter time.Duration) error {
+	if game.IsOver() {
+		return newUserError(messageGameOver, nil)
+	}
+
+	if player != game.WaitingPlayer() {
+		return newUserError(&i18n.Message{
+			ID:    "chess.error.not_waiting",
+			Other: "only the player waiting for a move can do this",
+		}, nil)
+	}
+
+	if abandonAfter <= 0 || idleTime(game) < abandonAfter {
+		return newUserError(&i18n.Message{
+			ID:    "chess.error.not_abandoned",
+			Other: "the game has not been abandoned",
+		}, nil)
+	}
+
+	return nil
+}
+
+func idleTime(game *Game) time.Duration {
+	return time.Duration(model.GetMillis()-game.LastMoveAt) * time.Millisecond
+}
+
+func (gm *GameManager) formatIdle(l *i18n.Localizer, idle time.Duration) string {
+	hours := int(idle.Hours())
+	if hours < 48 {
+		return gm.localizePlural(l, &i18n.Message{
+			ID:    "chess.duration.hours",
+			One:   "{{.Count}} hour",
+			Other: "{{.Count}} hours",
+		}, hours, nil)
+	}
+	return gm.localizePlural(l, &i18n.Message{
+		ID:    "chess.duration.days",
+		One:   "{{.Count}} day",
+		Other: "{{.Count}} days",
+	}, hours/24, nil)
+}
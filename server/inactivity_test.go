@@ -0,0 +1,145 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/plugin/plugintest"
+	"github.com/notnil/chess"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// startIdleTestGame stores a game in progress whose last move was idle ago.
+func startIdleTestGame(t *testing.T, gm *GameManager, idle time.Duration, moves ...string) *Game {
+	game := startTestGame(t, gm, moves...)
+	game.LastMoveAt = model.GetMillis() - idle.Milliseconds()
+	require.NoError(t, gm.saveGame(game))
+	return game
+}
+
+// createdPosts returns the posts created through the API mock.
+func createdPosts(api *plugintest.API) []*model.Post {
+	posts := []*model.Post{}
+	for _, call := range api.Calls {
+		if call.Method == "CreatePost" {
+			posts = append(posts, call.Arguments.Get(0).(*model.Post))
+		}
+	}
+	return posts
+}
+
+func TestCheckInactiveGames(t *testing.T) {
+	const (
+		remindAfter  = 24 * time.Hour
+		abandonAfter = 72 * time.Hour
+	)
+
+	for _, tc := range []struct {
+		name    string
+		idle    time.Duration
+		moves   []string
+		edit    func(game *Game)
+		remind  bool
+		offer   bool
+		actions int
+	}{
+		{name: "recent move", idle: time.Hour, moves: []string{"e4", "e5"}},
+		{name: "reminder", idle: 30 * time.Hour, moves: []string{"e4", "e5"}, remind: true},
+		{
+			name:  "reminder already sent",
+			idle:  30 * time.Hour,
+			moves: []string{"e4", "e5"},
+			edit:  func(game *Game) { game.RemindedAt = model.GetMillis() },
+		},
+		{name: "abandoned", idle: 80 * time.Hour, moves: []string{"e4", "e5"}, offer: true, actions: 2},
+		{name: "abandoned before both sides moved", idle: 80 * time.Hour, moves: []string{"e4"}, offer: true, actions: 1},
+		{
+			name:  "claim already offered",
+			idle:  80 * time.Hour,
+			moves: []string{"e4", "e5"},
+			edit:  func(game *Game) { game.ClaimOfferedAt = model.GetMillis() },
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			api := setupTestAPI(t)
+			gm, store := setupTestGameManager(t, api)
+			game := startIdleTestGame(t, gm, tc.idle, tc.moves...)
+			if tc.edit != nil {
+				tc.edit(game)
+				require.NoError(t, gm.saveGame(game))
+			}
+
+			gm.CheckInactiveGames(remindAfter, abandonAfter)
+
+			stored, err := store.GetGame(game.ID)
+			require.NoError(t, err)
+			posts := createdPosts(api)
+			switch {
+			case tc.remind:
+				assert.NotZero(t, stored.RemindedAt)
+				require.Len(t, posts, 1)
+			case tc.offer:
+				assert.NotZero(t, stored.ClaimOfferedAt)
+				require.Len(t, posts, 1)
+				attachments := posts[0].Attachments()
+				require.Len(t, attachments, 1)
+				assert.Len(t, attachments[0].Actions, tc.actions)
+			default:
+				assert.Empty(t, posts)
+			}
+		})
+	}
+}
+
+func TestClaimAbandonment(t *testing.T) {
+	const abandonAfter = 72 * time.Hour
+
+	for _, tc := range []struct {
+		name        string
+		idle        time.Duration
+		moves       []string
+		byAbsent    bool
+		abort       bool
+		expectError bool
+		outcome     chess.Outcome
+		termination string
+	}{
+		{name: "claim", idle: 80 * time.Hour, moves: []string{"e4", "e5"}, outcome: chess.BlackWon, termination: terminationAbandoned},
+		{name: "claim before both sides moved", idle: 80 * time.Hour, moves: []string{"e4"}, expectError: true},
+		{name: "claim too early", idle: time.Hour, moves: []string{"e4", "e5"}, expectError: true},
+		{name: "claim by the absent player", idle: 80 * time.Hour, moves: []string{"e4", "e5"}, byAbsent: true, expectError: true},
+		{name: "abort", idle: 80 * time.Hour, moves: []string{"e4", "e5"}, abort: true, outcome: chess.NoOutcome, termination: terminationAborted},
+		{name: "abort before both sides moved", idle: 80 * time.Hour, moves: []string{"e4"}, abort: true, outcome: chess.NoOutcome, termination: terminationAborted},
+		{name: "abort too early", idle: time.Hour, moves: []string{"e4"}, abort: true, expectError: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			api := setupTestAPI(t)
+			gm, store := setupTestGameManager(t, api)
+			game := startIdleTestGame(t, gm, tc.idle, tc.moves...)
+
+			player := game.WaitingPlayer()
+			if tc.byAbsent {
+				player = game.Opponent(player)
+			}
+			var err error
+			if tc.abort {
+				_, err = gm.AbortAbandoned(game.ID, player, abandonAfter)
+			} else {
+				_, err = gm.ClaimAbandonment(game.ID, player, abandonAfter)
+			}
+			if tc.expectError {
+				require.Error(t, err)
+				assert.False(t, gm.isArchived(game.ID))
+				return
+			}
+			require.NoError(t, err)
+
+			archived, err := store.GetArchivedGame(game.ID)
+			require.NoError(t, err)
+			assert.Equal(t, tc.outcome, archived.Outcome)
+			assert.Equal(t, tc.termination, archived.Termination)
+		})
+	}
+}
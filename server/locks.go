@@ -0,0 +1,51 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/mattermost/mattermost-plugin-api/cluster"
+	"github.com/mattermost/mattermost-plugin-api/i18n"
+)
+
+const (
+	gameLockPrefix    = "lock_game_"
+	channelLockPrefix = "lock_channel_"
+	gameLockTimeout   = 10 * time.Second
+)
+
+// lockGame takes a cluster wide lock on the game, so moves, resignations and the
+// inactivity job from any plugin instance read and write the game one after the other.
+// The lock is retried until gameLockTimeout.
+func (gm *GameManager) lockGame(id string) (func(), error) {
+	return gm.lock(gameLockPrefix + id)
+}
+
+// lockChannel takes a cluster wide lock on the game played in the channel, so only
+// one game at a time can be started there. It does not exclude updates to the
+// current game of the channel: starting a game only reads whether that game is
+// over, which never changes back once it is. When both locks are needed, as when
+// accepting a rematch, the game lock is taken first.
+func (gm *GameManager) lockChannel(channelID string) (func(), error) {
+	return gm.lock(channelLockPrefix + channelID)
+}
+
+func (gm *GameManager) lock(key string) (func(), error) {
+	mutex, err := cluster.NewMutex(gm.api, key)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), gameLockTimeout)
+	defer cancel()
+
+	err = mutex.LockWithContext(ctx)
+	if err != nil {
+		return nil, newUserError(&i18n.Message{
+			ID:    "chess.error.locked",
+			Other: "the game is being updated, please try again",
+		}, nil)
+	}
+
+	return mutex.Unlock, nil
+}
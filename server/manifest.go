@@ -35,7 +35,64 @@ const manifestStr = `
   "settings_schema": {
     "header": "",
     "footer": "",
-    "settings": []
+    "settings": [
+      {
+        "key": "ReminderHours",
+        "display_name": "Reminder after (hours):",
+        "type": "number",
+        "help_text": "Remind players that it is their turn after this many hours without moving. Set to 0 to disable reminders.",
+        "placeholder": "",
+        "default": 24
+      },
+      {
+        "key": "AbandonmentHours",
+        "display_name": "Abandonment after (hours):",
+        "type": "number",
+        "help_text": "After this many hours without a move, the waiting player can claim the win by abandonment or abort the game. Set to 0 to disable it.",
+        "placeholder": "",
+        "default": 72
+      },
+      {
+        "key": "PNGBoardImages",
+        "display_name": "Use PNG board images:",
+        "type": "bool",
+        "help_text": "Show the board in posts as a PNG image instead of an SVG one. Use it if some clients or email notifications do not display the board.",
+        "placeholder": "",
+        "default": false
+      },
+      {
+        "key": "BoardThemes",
+        "display_name": "Board themes:",
+        "type": "longtext",
+        "help_text": "Extra board themes, one per line: a name followed by the light square, dark square, last move and warning colors, e.g. \"purple #e8e0f0 #8a6fb0 #ffff00 #ff0000\". The last move and warning colors can be left out. The built-in themes are brown, green, blue and gray.",
+        "placeholder": "",
+        "default": ""
+      },
+      {
+        "key": "DefaultBoardTheme",
+        "display_name": "Default board theme:",
+        "type": "text",
+        "help_text": "Theme of the boards posted in the channels, and of the users who did not choose one.",
+        "placeholder": "",
+        "default": "brown"
+      },
+      {
+        "key": "MoveLog",
+        "display_name": "Log moves in the game thread:",
+        "type": "bool",
+        "help_text": "Reply to the game post with every move of new games, so the channel keeps a readable history. Players can also turn it on or off for their current game with \"/chess log on|off\".",
+        "placeholder": "",
+        "default": false
+      },
+      {
+        "key": "ReplayFrameMilliseconds",
+        "display_name": "Replay frame duration (milliseconds):",
+        "type": "number",
+        "help_text": "How long each position is shown in the animated replays of the games. The final position is shown three times longer.",
+        "placeholder": "",
+        "default": 1000
+      }
+    ]
   }
 }
 `
@@ -0,0 +1,236 @@
+package main
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+
+	"github.com/mattermost/mattermost-plugin-api/cluster"
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/notnil/chess"
+	"github.com/pkg/errors"
+)
+
+const (
+	migrationVersionKey = "migration_version"
+	migrationVersion    = 3
+	migrationPageSize   = 100
+
+	// Tags the players, channel and post were kept in before games were stored as
+	// versioned records.
+	legacyWhiteTag   = "white"
+	legacyBlackTag   = "black"
+	legacyChannelTag = "channel"
+	legacyPostTag    = "post"
+)
+
+// migrate brings the stored data up to migrationVersion, on a single node of the
+// cluster. The migrations are:
+//  1. converting the games stored as bare PGN under their channel ID into game records;
+//  2. naming the opening of the archived games with its ECO code and name, instead
+//     of their first moves;
+//  3. posting the games again, as board images posted before they were signed are
+//     no longer served.
+func (p *Plugin) migrate() error {
+	if p.migratedVersion() >= migrationVersion {
+		return nil
+	}
+
+	mutex, err := cluster.NewMutex(p.API, "migration")
+	if err != nil {
+		return err
+	}
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	version := p.migratedVersion()
+	if version >= migrationVersion {
+		return nil
+	}
+
+	keys := []string{}
+	for page := 0; ; page++ {
+		pageKeys, appErr := p.API.KVList(page, migrationPageSize)
+		if appErr != nil {
+			return appErr
+		}
+		keys = append(keys, pageKeys...)
+		if len(pageKeys) < migrationPageSize {
+			break
+		}
+	}
+
+	store := p.gameManager.store
+	for _, key := range keys {
+		switch {
+		case version < 1 && model.IsValidId(key):
+			err = p.migrateLegacyGame(store, key)
+		case version < 2 && strings.HasPrefix(key, archivedGamePrefix):
+			err = p.migrateArchivedOpening(store, strings.TrimPrefix(key, archivedGamePrefix))
+		case version < 3 && strings.HasPrefix(key, gameKeyPrefix):
+			err = p.migrateGamePost(store, strings.TrimPrefix(key, gameKeyPrefix))
+		default:
+			continue
+		}
+		if err != nil {
+			return errors.Wrapf(err, "failed to migrate key %s", key)
+		}
+	}
+
+	return appErrToError(p.API.KVSet(migrationVersionKey, []byte(strconv.Itoa(migrationVersion))))
+}
+
+// migratedVersion returns the version the data was last migrated to, 0 if it never was.
+func (p *Plugin) migratedVersion() int {
+	b, appErr := p.API.KVGet(migrationVersionKey)
+	if appErr != nil || b == nil {
+		return 0
+	}
+
+	version, err := strconv.Atoi(string(b))
+	if err != nil {
+		return 0
+	}
+	return version
+}
+
+// migrateLegacyGame converts the game kept under channelID. The game record takes
+// the channel ID as its ID and is saved last, once it is indexed, so a migration
+// interrupted at any point converts the game again or just removes the legacy key.
+func (p *Plugin) migrateLegacyGame(store GameStore, channelID string) error {
+	_, err := store.GetGame(channelID)
+	if err == nil {
+		return appErrToError(p.API.KVDelete(channelID))
+	}
+	if err != ErrNotFound {
+		return err
+	}
+
+	b, appErr := p.API.KVGet(channelID)
+	if appErr != nil {
+		return appErr
+	}
+	if b == nil {
+		return nil
+	}
+
+	pgn, err := chess.PGN(bytes.NewReader(b))
+	if err != nil {
+		p.API.LogWarn("Skipping key that is not a game", "key", channelID, "error", err.Error())
+		return nil
+	}
+	legacy := chess.NewGame(pgn)
+	// Any text parses as a PGN without moves, but games always had their players.
+	if legacy.GetTagPair(legacyWhiteTag) == nil || legacy.GetTagPair(legacyBlackTag) == nil {
+		p.API.LogWarn("Skipping key that is not a game", "key", channelID)
+		return nil
+	}
+
+	// Legacy games did not keep any dates, so they count as played now.
+	now := model.GetMillis()
+	game := &Game{
+		GameMetadata: GameMetadata{
+			ID:         channelID,
+			ChannelID:  channelID,
+			PostID:     popTag(legacy, legacyPostTag),
+			WhiteID:    popTag(legacy, legacyWhiteTag),
+			BlackID:    popTag(legacy, legacyBlackTag),
+			Variant:    variantStandard,
+			CreatedAt:  now,
+			UpdatedAt:  now,
+			LastMoveAt: now,
+		},
+	}
+	popTag(legacy, legacyChannelTag)
+	game.Game = legacy
+	game.reclassifyOpening()
+
+	err = store.SetChannelGameID(channelID, game.ID)
+	if err != nil {
+		return err
+	}
+
+	if game.IsOver() {
+		err = p.gameManager.archiveGame(game)
+		if err != nil {
+			return err
+		}
+	} else {
+		p.gameManager.addActiveGame(game)
+	}
+
+	err = store.SaveGame(game)
+	if err != nil {
+		return err
+	}
+
+	// Games converted now are not among the keys listed for step 3.
+	p.updateGamePost(game)
+
+	return appErrToError(p.API.KVDelete(channelID))
+}
+
+// migrateArchivedOpening names the opening of an archived game from its moves.
+// Games archived before the ECO table named it after their first moves.
+func (p *Plugin) migrateArchivedOpening(store GameStore, id string) error {
+	archived, err := store.GetArchivedGame(id)
+	if err != nil {
+		return err
+	}
+
+	pgn, err := chess.PGN(bytes.NewBufferString(archived.PGN))
+	if err != nil {
+		p.API.LogWarn("Skipping archived game that cannot be read", "id", id, "error", err.Error())
+		return nil
+	}
+	game := &Game{Game: chess.NewGame(pgn)}
+	game.reclassifyOpening()
+
+	if archived.Opening == game.OpeningName() {
+		return nil
+	}
+	archived.Opening = game.OpeningName()
+	return store.SaveArchivedGame(archived)
+}
+
+// migrateGamePost posts the game again, so its board image URL is signed.
+func (p *Plugin) migrateGamePost(store GameStore, id string) error {
+	game, err := store.GetGame(id)
+	if err != nil {
+		p.API.LogWarn("Skipping game that cannot be read", "id", id, "error", err.Error())
+		return nil
+	}
+
+	p.updateGamePost(game)
+	return nil
+}
+
+// updateGamePost replaces the post of the game. Posts deleted since are left alone.
+func (p *Plugin) updateGamePost(game *Game) {
+	if game.PostID == "" {
+		return
+	}
+
+	if _, appErr := p.API.UpdatePost(p.gameManager.gameToPost(game)); appErr != nil {
+		p.API.LogWarn("Could not update the game post", "id", game.ID, "post", game.PostID, "error", appErr.Error())
+	}
+}
+
+// popTag removes the tag from the game and returns its value.
+func popTag(game *chess.Game, key string) string {
+	pair := game.GetTagPair(key)
+	if pair == nil {
+		return ""
+	}
+
+	value := pair.Value
+	game.RemoveTagPair(key)
+	return value
+}
+
+func appErrToError(appErr *model.AppError) error {
+	if appErr == nil {
+		return nil
+	}
+	return appErr
+}
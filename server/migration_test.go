@@ -0,0 +1,272 @@
+package main
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/plugin/plugintest"
+	"github.com/mattermost/mattermost-server/v5/plugin/plugintest/mock"
+	"github.com/notnil/chess"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// legacyGame is a game as stored before game records: a bare PGN under the ID of
+// its channel, with the players, channel and post in tags.
+type legacyGame struct {
+	channelID string
+	whiteID   string
+	blackID   string
+	postID    string
+	moves     []string
+}
+
+func newLegacyGame(moves ...string) *legacyGame {
+	return &legacyGame{
+		channelID: model.NewId(),
+		whiteID:   model.NewId(),
+		blackID:   model.NewId(),
+		postID:    model.NewId(),
+		moves:     moves,
+	}
+}
+
+func (l *legacyGame) pgn(t *testing.T) []byte {
+	game := chess.NewGame()
+	game.AddTagPair(legacyWhiteTag, l.whiteID)
+	game.AddTagPair(legacyBlackTag, l.blackID)
+	game.AddTagPair(legacyChannelTag, l.channelID)
+	game.AddTagPair(legacyPostTag, l.postID)
+	for _, move := range l.moves {
+		require.NoError(t, game.MoveStr(move))
+	}
+	return []byte(game.String())
+}
+
+func TestMigrate(t *testing.T) {
+	inProgress := newLegacyGame("e4", "e5")
+	finished := newLegacyGame("e4", "e5", "Bc4", "Nc6", "Qh5", "Nf6", "Qxf7")
+	notAGame := model.NewId()
+	archivedID := model.NewId()
+	interrupted := newLegacyGame("d4", "d5")
+	converted := newLegacyGame("c4")
+	posted := playTestGame(t, "e4")
+	postDeleted := playTestGame(t, "d4")
+
+	for _, tc := range []struct {
+		name string
+		// version is the version the data was migrated to, empty if never.
+		version string
+		// kv holds the keys of the KV store besides the migration version. Only the
+		// ones read by the migration are mocked.
+		kv map[string][]byte
+		// archived is stored in the archive before the migration.
+		archived *ArchivedGame
+		// setup stores what an interrupted migration left behind.
+		setup func(t *testing.T, gm *GameManager, store *MemoryGameStore)
+		// deleted are the keys the migration removes.
+		deleted []string
+		// updated are the posts the migration updates, and failed the ones that no
+		// longer exist.
+		updated []string
+		failed  []string
+		check   func(t *testing.T, gm *GameManager, store *MemoryGameStore)
+	}{
+		{
+			name:    "game in progress",
+			kv:      map[string][]byte{inProgress.channelID: inProgress.pgn(t)},
+			deleted: []string{inProgress.channelID},
+			updated: []string{inProgress.postID},
+			check: func(t *testing.T, gm *GameManager, store *MemoryGameStore) {
+				game, err := gm.getChannelGame(inProgress.channelID)
+				require.NoError(t, err)
+				assert.Equal(t, inProgress.channelID, game.ID)
+				assert.Equal(t, inProgress.whiteID, game.WhiteID)
+				assert.Equal(t, inProgress.blackID, game.BlackID)
+				assert.Equal(t, inProgress.postID, game.PostID)
+				assert.Equal(t, inProgress.channelID, game.ChannelID)
+				assert.Len(t, game.Moves(), 2)
+				assert.Nil(t, game.GetTagPair(legacyWhiteTag))
+				assert.Nil(t, game.GetTagPair(legacyChannelTag))
+				assert.Equal(t, "C20 King's Pawn Game", game.OpeningName())
+				assert.False(t, gm.isArchived(game.ID))
+
+				// Posts of legacy games refer to them by channel.
+				assert.True(t, gm.CanMove(inProgress.channelID, inProgress.whiteID))
+
+				active, err := gm.GetActiveGames(inProgress.blackID)
+				require.NoError(t, err)
+				require.Len(t, active, 1)
+				assert.Equal(t, game.ID, active[0].ID)
+			},
+		},
+		{
+			name:    "finished game",
+			kv:      map[string][]byte{finished.channelID: finished.pgn(t)},
+			deleted: []string{finished.channelID},
+			updated: []string{finished.postID},
+			check: func(t *testing.T, gm *GameManager, store *MemoryGameStore) {
+				game, err := gm.getChannelGame(finished.channelID)
+				require.NoError(t, err)
+				assert.True(t, game.IsOver())
+
+				archived, err := store.GetArchivedGame(game.ID)
+				require.NoError(t, err)
+				assert.Equal(t, chess.WhiteWon, archived.Outcome)
+				assert.Equal(t, "C23 Bishop's Opening", archived.Opening)
+
+				games, err := gm.GetArchivedGames(finished.whiteID)
+				require.NoError(t, err)
+				assert.Len(t, games, 1)
+
+				active, err := store.GetIndex(allActiveGamesIndex)
+				require.NoError(t, err)
+				assert.Empty(t, active)
+			},
+		},
+		{
+			name:    "interrupted before the game was saved",
+			kv:      map[string][]byte{interrupted.channelID: interrupted.pgn(t)},
+			deleted: []string{interrupted.channelID},
+			updated: []string{interrupted.postID},
+			setup: func(t *testing.T, gm *GameManager, store *MemoryGameStore) {
+				require.NoError(t, store.SetChannelGameID(interrupted.channelID, interrupted.channelID))
+				require.NoError(t, store.AddToIndex(allActiveGamesIndex, interrupted.channelID))
+			},
+			check: func(t *testing.T, gm *GameManager, store *MemoryGameStore) {
+				game, err := gm.getChannelGame(interrupted.channelID)
+				require.NoError(t, err)
+				assert.Len(t, game.Moves(), 2)
+
+				active, err := store.GetIndex(allActiveGamesIndex)
+				require.NoError(t, err)
+				assert.Equal(t, []string{interrupted.channelID}, active)
+			},
+		},
+		{
+			name:    "interrupted before the legacy key was deleted",
+			kv:      map[string][]byte{converted.channelID: converted.pgn(t)},
+			deleted: []string{converted.channelID},
+			setup: func(t *testing.T, gm *GameManager, store *MemoryGameStore) {
+				// The converted game was played on before the migration ran again.
+				game := &Game{Game: chess.NewGame(), GameMetadata: GameMetadata{ID: converted.channelID, ChannelID: converted.channelID}}
+				require.NoError(t, game.MoveStr("c4"))
+				require.NoError(t, game.MoveStr("e5"))
+				require.NoError(t, store.SaveGame(game))
+				require.NoError(t, store.SetChannelGameID(converted.channelID, converted.channelID))
+			},
+			check: func(t *testing.T, gm *GameManager, store *MemoryGameStore) {
+				game, err := gm.getChannelGame(converted.channelID)
+				require.NoError(t, err)
+				assert.Len(t, game.Moves(), 2)
+			},
+		},
+		{
+			name: "key that is not a game",
+			kv:   map[string][]byte{notAGame: []byte(`{"some":"json"}`)},
+			check: func(t *testing.T, gm *GameManager, store *MemoryGameStore) {
+				_, err := store.GetChannelGameID(notAGame)
+				assert.Equal(t, ErrNotFound, err)
+			},
+		},
+		{
+			name:    "opening of an archived game",
+			version: "1",
+			kv:      map[string][]byte{archivedGamePrefix + archivedID: nil},
+			archived: &ArchivedGame{
+				ID:      archivedID,
+				Opening: "1. e4 e5",
+				PGN:     string(finished.pgn(t)),
+			},
+			check: func(t *testing.T, gm *GameManager, store *MemoryGameStore) {
+				archived, err := store.GetArchivedGame(archivedID)
+				require.NoError(t, err)
+				assert.Equal(t, "C23 Bishop's Opening", archived.Opening)
+			},
+		},
+		{
+			name:    "post of a game",
+			version: "2",
+			kv: map[string][]byte{
+				gameKeyPrefix + posted.ID:      nil,
+				gameKeyPrefix + postDeleted.ID: nil,
+			},
+			setup: func(t *testing.T, gm *GameManager, store *MemoryGameStore) {
+				require.NoError(t, store.SaveGame(posted))
+				require.NoError(t, store.SaveGame(postDeleted))
+			},
+			updated: []string{posted.PostID},
+			failed:  []string{postDeleted.PostID},
+			check:   func(t *testing.T, gm *GameManager, store *MemoryGameStore) {},
+		},
+		{
+			name:    "legacy games are not migrated again",
+			version: "1",
+			kv:      map[string][]byte{inProgress.channelID: inProgress.pgn(t)},
+			check: func(t *testing.T, gm *GameManager, store *MemoryGameStore) {
+				_, err := store.GetChannelGameID(inProgress.channelID)
+				assert.Equal(t, ErrNotFound, err)
+			},
+		},
+		{
+			name:    "already migrated",
+			version: strconv.Itoa(migrationVersion),
+			check:   func(t *testing.T, gm *GameManager, store *MemoryGameStore) {},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			api := setupTestAPI(t)
+			gm, store := setupTestGameManager(t, api)
+			if tc.archived != nil {
+				require.NoError(t, store.SaveArchivedGame(tc.archived))
+			}
+			if tc.setup != nil {
+				tc.setup(t, gm, store)
+			}
+
+			var version []byte
+			if tc.version != "" {
+				version = []byte(tc.version)
+			}
+			api.On("KVGet", migrationVersionKey).Return(version, nil)
+			if tc.version != strconv.Itoa(migrationVersion) {
+				mockMigrationKV(api, tc.kv)
+				for _, key := range tc.deleted {
+					api.On("KVDelete", key).Return(nil).Once()
+				}
+				for _, postID := range tc.updated {
+					api.On("UpdatePost", mock.MatchedBy(isPost(postID))).Return(&model.Post{Id: postID}, nil).Once()
+				}
+				for _, postID := range tc.failed {
+					api.On("UpdatePost", mock.MatchedBy(isPost(postID))).Return(nil, &model.AppError{Message: "not found"}).Once()
+				}
+				api.On("KVSet", migrationVersionKey, []byte(strconv.Itoa(migrationVersion))).Return(nil).Once()
+			}
+
+			p := &Plugin{gameManager: *gm}
+			p.SetAPI(api)
+			require.NoError(t, p.migrate())
+
+			tc.check(t, gm, store)
+		})
+	}
+}
+
+// isPost returns a matcher of the post with the given ID.
+func isPost(id string) func(*model.Post) bool {
+	return func(post *model.Post) bool { return post.Id == id }
+}
+
+// mockMigrationKV lists the keys of kv, and returns the values of the ones the
+// migration reads directly.
+func mockMigrationKV(api *plugintest.API, kv map[string][]byte) {
+	keys := []string{}
+	for key, value := range kv {
+		keys = append(keys, key)
+		if value != nil {
+			api.On("KVGet", key).Return(value, nil).Maybe()
+		}
+	}
+	api.On("KVList", 0, migrationPageSize).Return(keys, nil).Once()
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mattermost/mattermost-plugin-api/i18n"
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/notnil/chess"
+)
+
+// moveLogImageSize is the width in pixels of the boards posted in the move log.
+const moveLogImageSize = 200
+
+// logMove replies to the game post with the last move, when the game keeps a move
+// log. Unlike the updates of the game post, the replies notify the players
+// following the thread.
+func (gm *GameManager) logMove(game *Game) {
+	moves := game.Moves()
+	if !game.MoveLog || game.PostID == "" || len(moves) == 0 {
+		return
+	}
+
+	ply := len(moves)
+	move := moves[ply-1]
+	before := game.Positions()[ply-1]
+
+	number := fmt.Sprintf("%d.", (ply-1)/2+1)
+	if ply%2 == 0 {
+		number = fmt.Sprintf("%d...", ply/2)
+	}
+
+	// The log is shared by everyone, so it is written in the language of the server.
+	l := gm.serverLocalizer()
+	notes := []string{}
+	if move.HasTag(chess.Capture) {
+		captured := before.Board().Piece(move.S2())
+		if move.HasTag(chess.EnPassant) {
+			captured = chess.WhitePawn
+		}
+		notes = append(notes, gm.localize(l, &i18n.Message{
+			ID:    "chess.move.capture",
+			Other: "{{.Piece}} captured",
+		}, map[string]interface{}{"Piece": gm.localizePiece(l, captured.Type())}))
+	}
+	if move.Promo() != chess.NoPieceType {
+		notes = append(notes, gm.localize(l, messagePromotion, map[string]interface{}{"Piece": gm.localizePiece(l, move.Promo())}))
+	}
+	switch {
+	case game.Method() == chess.Checkmate:
+		notes = append(notes, gm.localize(l, &i18n.Message{
+			ID:    "chess.move.checkmate",
+			Other: "CHECKMATE!",
+		}, nil))
+	case move.HasTag(chess.Check):
+		notes = append(notes, gm.localize(l, messageCheck, nil))
+	}
+
+	message := fmt.Sprintf("**%s %s**", number, chess.AlgebraicNotation{}.Encode(before, move))
+	if len(notes) > 0 {
+		message += "\n" + strings.Join(notes, "\n")
+	}
+
+	post := &model.Post{
+		UserId:    gm.botID,
+		ChannelId: game.ChannelID,
+		RootId:    game.PostID,
+		Message:   message,
+	}
+	// Only games shown as images get a board, the text one being too long for a reply.
+	if !game.HidesBoard() && game.Display != boardDisplayText {
+		// The board is shown from the side that played the move.
+		img := boardImageForPosition(game.Position(), move, before.Turn())
+		img.Coordinates = false
+		img.Format = boardImagePNG
+		img.Size = moveLogImageSize
+		model.ParseSlackAttachment(post, []*model.SlackAttachment{{ImageURL: gm.boardImageURL(img)}})
+	}
+
+	if _, appErr := gm.api.CreatePost(post); appErr != nil {
+		gm.api.LogError("could not log the move", "game", game.ID, "error", appErr.Error())
+	}
+}
+
+// SetMoveLog turns the move log of the game on or off. Only the players can change it.
+func (gm *GameManager) SetMoveLog(id, player string, on bool) error {
+	game, unlock, err := gm.getLockedGame(id)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if !game.IsPlayer(player) {
+		return newUserError(messageNotPlaying, nil)
+	}
+	if game.IsOver() {
+		return newUserError(messageGameOver, nil)
+	}
+
+	game.MoveLog = on
+	return gm.saveGame(game)
+}
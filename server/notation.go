@@ -0,0 +1,100 @@
+package main
+
+import "strings"
+
+const (
+	// NotationLetters writes the pieces with the letters of the user's language.
+	NotationLetters = "letters"
+	// NotationFigurine writes the pieces as figurines, e.g. ♘f3.
+	NotationFigurine = "figurine"
+)
+
+// englishPieceLetters are the letters of the king, queen, rook, bishop and knight
+// in standard algebraic notation. The other notations list their letters in the
+// same order.
+var englishPieceLetters = []rune("KQRBN")
+
+var figurinePieces = []rune("♔♕♖♗♘")
+
+// localePieceLetters are the piece letters of the Mattermost locales whose players
+// use their own. The locales are looked up without their region first.
+var localePieceLetters = map[string][]rune{
+	"de":    []rune("KDTLS"),
+	"es":    []rune("RDTAC"),
+	"fr":    []rune("RDTFC"),
+	"hu":    []rune("KVBFH"),
+	"it":    []rune("RDTAC"),
+	"nl":    []rune("KDTLP"),
+	"pl":    []rune("KHWGS"),
+	"pt":    []rune("RDTBC"),
+	"pt-BR": []rune("RDTBC"),
+	"ro":    []rune("RDTNC"),
+	"sv":    []rune("KDTLS"),
+	"tr":    []rune("ŞVKFA"),
+}
+
+// sanNotation is the way a user reads and writes the pieces in SAN.
+type sanNotation struct {
+	pieces []rune
+}
+
+// englishNotation is standard SAN, used in the posts shared by everyone.
+var englishNotation = sanNotation{pieces: englishPieceLetters}
+
+// notationFor returns the notation of the locale, or figurines.
+func notationFor(locale string, figurine bool) sanNotation {
+	if figurine {
+		return sanNotation{pieces: figurinePieces}
+	}
+	if letters, ok := localePieceLetters[locale]; ok {
+		return sanNotation{pieces: letters}
+	}
+	if letters, ok := localePieceLetters[strings.SplitN(locale, "-", 2)[0]]; ok {
+		return sanNotation{pieces: letters}
+	}
+	return englishNotation
+}
+
+// userNotation returns the notation of the user, based on their Mattermost locale
+// and their settings.
+func (gm *GameManager) userNotation(userID string) sanNotation {
+	locale := ""
+	if user, appErr := gm.api.GetUser(userID); appErr == nil {
+		locale = user.Locale
+	}
+	return notationFor(locale, gm.GetUserPreferences(userID).Notation == NotationFigurine)
+}
+
+// format writes the standard SAN move in the notation.
+func (n sanNotation) format(san string) string {
+	return translatePieces(san, englishPieceLetters, n.pieces)
+}
+
+// parse reads a move written in the notation into standard SAN. Figurines are
+// understood whatever the notation, and map straight to the English letters, as the
+// letters of some notations are the English letters of other pieces.
+func (n sanNotation) parse(movement string) string {
+	if strings.ContainsAny(movement, string(figurinePieces)) {
+		return translatePieces(movement, figurinePieces, englishPieceLetters)
+	}
+	return translatePieces(movement, n.pieces, englishPieceLetters)
+}
+
+// translatePieces replaces the pieces in from by the ones at the same position in to.
+// Pawns and castling are written the same way in every notation, so the only pieces
+// of a move are its first letter and the piece a pawn is promoted to, after the "=".
+func translatePieces(s string, from, to []rune) string {
+	runes := []rune(s)
+	for i, r := range runes {
+		if i > 0 && runes[i-1] != '=' {
+			continue
+		}
+		for j, piece := range from {
+			if r == piece {
+				runes[i] = to[j]
+				break
+			}
+		}
+	}
+	return string(runes)
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotationParse(t *testing.T) {
+	for _, tc := range []struct {
+		locale   string
+		figurine bool
+		movement string
+		san      string
+	}{
+		{locale: "en", movement: "Nf3", san: "Nf3"},
+		{locale: "en", movement: "e8=Q+", san: "e8=Q+"},
+		{locale: "de", movement: "Sf3", san: "Nf3"},
+		{locale: "de", movement: "Sbd7", san: "Nbd7"},
+		{locale: "de", movement: "Dxd8#", san: "Qxd8#"},
+		{locale: "de", movement: "e8=D", san: "e8=Q"},
+		{locale: "de", movement: "O-O-O", san: "O-O-O"},
+		{locale: "de", movement: "♘f3", san: "Nf3"},
+		{locale: "es", movement: "Rxe2", san: "Kxe2"},
+		{locale: "es", movement: "Te1", san: "Re1"},
+		{locale: "es", movement: "♖e1", san: "Re1"},
+		{locale: "es", movement: "bxa1=C", san: "bxa1=N"},
+		{locale: "fr", movement: "Fb5", san: "Bb5"},
+		{locale: "fr", movement: "♖a1", san: "Ra1"},
+		{locale: "fr", movement: "a8=D", san: "a8=Q"},
+		{locale: "hu", movement: "Bd1", san: "Rd1"},
+		{locale: "hu", movement: "Fc4", san: "Bc4"},
+		{locale: "hu", movement: "♗c4", san: "Bc4"},
+		{locale: "hu", movement: "h1=V", san: "h1=Q"},
+		{locale: "tr", movement: "Şe2", san: "Ke2"},
+		{locale: "tr", movement: "Kd1", san: "Rd1"},
+		{locale: "tr", movement: "♔e2", san: "Ke2"},
+		{locale: "tr", movement: "e8=V", san: "e8=Q"},
+		{locale: "pt-BR", movement: "Bc4", san: "Bc4"},
+		{locale: "de-AT", movement: "Lc4", san: "Bc4"},
+		{locale: "de", figurine: true, movement: "♘f3", san: "Nf3"},
+		{locale: "de", figurine: true, movement: "e8=♕", san: "e8=Q"},
+	} {
+		t.Run(tc.locale+" "+tc.movement, func(t *testing.T) {
+			assert.Equal(t, tc.san, notationFor(tc.locale, tc.figurine).parse(tc.movement))
+		})
+	}
+}
+
+func TestNotationFormat(t *testing.T) {
+	for _, tc := range []struct {
+		locale   string
+		figurine bool
+		san      string
+		movement string
+	}{
+		{locale: "en", san: "Nf3", movement: "Nf3"},
+		{locale: "de", san: "Nbd7", movement: "Sbd7"},
+		{locale: "de", san: "e8=Q+", movement: "e8=D+"},
+		{locale: "es", san: "Kxe2", movement: "Rxe2"},
+		{locale: "hu", san: "Rd1", movement: "Bd1"},
+		{locale: "tr", san: "Ke2", movement: "Şe2"},
+		{locale: "tr", san: "O-O", movement: "O-O"},
+		{locale: "fr", figurine: true, san: "Qxd8#", movement: "♕xd8#"},
+		{locale: "fr", figurine: true, san: "a1=N", movement: "a1=♘"},
+	} {
+		t.Run(tc.locale+" "+tc.san, func(t *testing.T) {
+			assert.Equal(t, tc.movement, notationFor(tc.locale, tc.figurine).format(tc.san))
+		})
+	}
+}
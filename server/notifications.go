@@ -0,0 +1,82 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/mattermost/mattermost-plugin-api/i18n"
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/notnil/chess"
+)
+
+// notifyTurn lets the player to move know that the opponent played, since
+// updating the board post does not trigger any notification.
+func (gm *GameManager) notifyTurn(game *Game) {
+	whiteUser, blackUser := gm.getPlayers(game)
+	player, opponent := whiteUser, blackUser
+	if game.Position().Turn() == chess.Black {
+		player, opponent = blackUser, whiteUser
+	}
+
+	baseURL := gm.api.GetConfig().ServiceSettings.SiteURL
+	l := gm.userLocalizer(player.Id)
+	san := gm.userNotation(player.Id).format(lastMoveSAN(game.Game))
+	message := gm.localize(l, &i18n.Message{
+		ID:    "chess.notification.turn",
+		Other: "@{{.Opponent}} played **{{.SAN}}**. It is your turn.",
+	}, map[string]interface{}{"Opponent": opponent.Username, "SAN": san})
+	if game.PostID != "" {
+		message += " " + gm.localize(l, messageGoToBoard, map[string]interface{}{"URL": fmt.Sprintf("%s/_redirect/pl/%s", *baseURL, game.PostID)})
+	}
+
+	gm.notifyPlayer(player, game.ChannelID, game.PostID, message)
+}
+
+// notifyPlayer sends a turn notification to player the way they chose in their
+// preferences, either by direct message or as a reply in the game thread.
+func (gm *GameManager) notifyPlayer(player *model.User, channelID, postID, message string) {
+	prefs := gm.GetUserPreferences(player.Id)
+	if prefs.Notifications == NotificationsOff {
+		return
+	}
+
+	gm.sendToPlayer(player, prefs, channelID, postID, message)
+}
+
+// sendToPlayer sends message to player as a reply in the game thread if they chose
+// so in their preferences, or else by direct message. It ignores whether turn
+// notifications are off, so it is meant for messages that are not about the turn.
+func (gm *GameManager) sendToPlayer(player *model.User, prefs *UserPreferences, channelID, postID, message string) {
+	post := &model.Post{
+		UserId:  gm.botID,
+		Message: message,
+	}
+
+	switch prefs.Notifications {
+	case NotificationsThread:
+		post.ChannelId = channelID
+		post.RootId = postID
+		post.Message = "@" + player.Username + " " + message
+	default:
+		dm, appErr := gm.api.GetDirectChannel(gm.botID, player.Id)
+		if appErr != nil {
+			gm.api.LogError("could not get bot direct channel", "user", player.Id, "error", appErr.Error())
+			return
+		}
+		post.ChannelId = dm.Id
+	}
+
+	_, appErr := gm.api.CreatePost(post)
+	if appErr != nil {
+		gm.api.LogError("could not notify player", "user", player.Id, "error", appErr.Error())
+	}
+}
+
+func lastMoveSAN(game *chess.Game) string {
+	moves := game.Moves()
+	if len(moves) == 0 {
+		return ""
+	}
+
+	positions := game.Positions()
+	return chess.AlgebraicNotation{}.Encode(positions[len(moves)-1], moves[len(moves)-1])
+}
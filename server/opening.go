@@ -0,0 +1,84 @@
+package main
+
+import (
+	"strings"
+
+	"github.com/notnil/chess"
+	"github.com/pkg/errors"
+)
+
+// ecoPositions maps the positions reached by the moves of ecoOpenings to their
+// opening. It is loaded when the plugin is activated, and no game is classified
+// until then.
+var ecoPositions map[string]*ecoOpening
+
+// loadECOPositions checks the moves of every opening of the table and indexes
+// the openings by the position they reach.
+func loadECOPositions() error {
+	positions, err := buildECOPositions(ecoOpenings)
+	if err != nil {
+		return err
+	}
+
+	ecoPositions = positions
+	return nil
+}
+
+func buildECOPositions(openings []ecoOpening) (map[string]*ecoOpening, error) {
+	positions := map[string]*ecoOpening{}
+	for i := range openings {
+		o := &openings[i]
+		game := chess.NewGame()
+		for _, san := range strings.Fields(o.Moves) {
+			if err := game.MoveStr(san); err != nil {
+				return nil, errors.Wrapf(err, "invalid ECO opening %s %s", o.Code, o.Name)
+			}
+		}
+		positions[positionKey(game.Position())] = o
+	}
+
+	return positions, nil
+}
+
+// positionKey identifies a position regardless of the move counters, so openings
+// reached through other move orders are recognized.
+func positionKey(pos *chess.Position) string {
+	fields := strings.Fields(pos.String())
+	return strings.Join(fields[:4], " ")
+}
+
+// findOpening returns the opening of the position, or nil if it is not in the table.
+func findOpening(pos *chess.Position) *ecoOpening {
+	return ecoPositions[positionKey(pos)]
+}
+
+// classifyOpening updates the opening of the game after a move. Once the game
+// leaves the table, it keeps the last opening it went through.
+func (g *Game) classifyOpening() {
+	if o := findOpening(g.Position()); o != nil {
+		g.ECO = o.Code
+		g.Opening = o.Name
+	}
+}
+
+// reclassifyOpening sets the opening of the game from all its positions, for games
+// whose moves were not classified as they were played.
+func (g *Game) reclassifyOpening() {
+	g.ECO = ""
+	g.Opening = ""
+	for _, pos := range g.Positions() {
+		if o := findOpening(pos); o != nil {
+			g.ECO = o.Code
+			g.Opening = o.Name
+		}
+	}
+}
+
+// OpeningName returns the ECO code and name of the opening, e.g. "C50 Italian Game",
+// or an empty string if the game has not been classified.
+func (g *Game) OpeningName() string {
+	if g.ECO == "" {
+		return ""
+	}
+	return g.ECO + " " + g.Opening
+}
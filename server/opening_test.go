@@ -0,0 +1,52 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildECOPositions(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		openings    []ecoOpening
+		expectError bool
+	}{
+		{name: "embedded table", openings: ecoOpenings},
+		{name: "valid opening", openings: []ecoOpening{{"C50", "Italian Game", "e4 e5 Nf3 Nc6 Bc4"}}},
+		{name: "illegal move", openings: []ecoOpening{{"C50", "Italian Game", "e4 e5 Nf3 Nc6 Bc5"}}, expectError: true},
+		{name: "not a move", openings: []ecoOpening{{"A00", "Typo", "e4 ee5"}}, expectError: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			positions, err := buildECOPositions(tc.openings)
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotEmpty(t, positions)
+		})
+	}
+}
+
+func TestReclassifyOpening(t *testing.T) {
+	require.NoError(t, loadECOPositions())
+
+	for _, tc := range []struct {
+		name    string
+		moves   []string
+		opening string
+	}{
+		{name: "no moves"},
+		{name: "in the table", moves: []string{"e4", "e5", "Nf3", "Nc6", "Bc4"}, opening: "C50 Italian Game"},
+		{name: "other move order", moves: []string{"Nf3", "Nc6", "e4", "e5", "Bc4"}, opening: "C50 Italian Game"},
+		{name: "out of the table keeps the last opening", moves: []string{"e4", "e5", "Nf3", "Nc6", "Bc4", "h6", "a3"}, opening: "C50 Italian Game"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			game := playTestGame(t, tc.moves...)
+			game.reclassifyOpening()
+			assert.Equal(t, tc.opening, game.OpeningName())
+		})
+	}
+}
@@ -6,6 +6,8 @@ import (
 
 	"github.com/gorilla/mux"
 	"github.com/larkox/mattermost-plugin-badges/badgesmodel"
+	"github.com/mattermost/mattermost-plugin-api/cluster"
+	"github.com/mattermost/mattermost-plugin-api/i18n"
 	"github.com/mattermost/mattermost-server/v5/model"
 	"github.com/mattermost/mattermost-server/v5/plugin"
 	"github.com/pkg/errors"
@@ -24,9 +26,10 @@ type Plugin struct {
 
 	BotUserID string
 
-	gameManager GameManager
-	router      *mux.Router
-	badgesMap   map[string]badgesmodel.BadgeID
+	gameManager   GameManager
+	router        *mux.Router
+	badgesMap     map[string]badgesmodel.BadgeID
+	inactivityJob *cluster.Job
 }
 
 // ServeHTTP demonstrates a plugin that handles HTTP requests by greeting the world.
@@ -46,10 +49,41 @@ func (p *Plugin) OnActivate() error {
 	}
 	p.BotUserID = botID
 
-	p.gameManager = NewGameManager(p.API, botID, p.GrantBadge)
+	p.gameManager = NewGameManager(p.API, NewKVGameStore(p.API), botID, p.GrantBadge, p.getConfiguration)
+	p.gameManager.imageKey, err = ensureImageSigningKey(p.API)
+	if err != nil {
+		return errors.Wrap(err, "failed to get the image signing key")
+	}
+	p.gameManager.bundle, err = i18n.InitBundle(p.API, i18nPath)
+	if err != nil {
+		return errors.Wrap(err, "failed to load the translations")
+	}
+
+	if err = loadECOPositions(); err != nil {
+		return errors.Wrap(err, "failed to load the openings")
+	}
+
+	if err = p.migrate(); err != nil {
+		return errors.Wrap(err, "failed to migrate games")
+	}
 
 	p.initializeAPI()
 	p.EnsureBadges()
 
+	p.inactivityJob, err = cluster.Schedule(p.API, "inactivity_check", cluster.MakeWaitForInterval(inactivityCheckInterval), p.checkInactiveGames)
+	if err != nil {
+		return errors.Wrap(err, "failed to schedule inactivity check")
+	}
+
 	return p.API.RegisterCommand(getCommand())
 }
+
+func (p *Plugin) OnDeactivate() error {
+	if p.inactivityJob != nil {
+		if err := p.inactivityJob.Close(); err != nil {
+			p.API.LogError("failed to close inactivity job", "error", err.Error())
+		}
+	}
+
+	return nil
+}
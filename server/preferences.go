@@ -0,0 +1,158 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mattermost/mattermost-plugin-api/i18n"
+)
+
+const (
+	NotificationsDM     = "dm"
+	NotificationsThread = "thread"
+	NotificationsOff    = "off"
+)
+
+// UserPreferences holds the per user settings changed through `/chess settings`.
+type UserPreferences struct {
+	Notifications string `json:"notifications"`
+	// Coordinates shows the rank and file labels on the boards shown only to the user.
+	Coordinates bool `json:"coordinates"`
+	// Theme and Pieces are the board theme and piece set of the boards shown only to
+	// the user. Empty values use the defaults.
+	Theme  string `json:"theme,omitempty"`
+	Pieces string `json:"pieces,omitempty"`
+	// Notation is how the pieces of the moves are written. Empty uses the letters of
+	// the user's language.
+	Notation string `json:"notation,omitempty"`
+}
+
+func defaultUserPreferences() *UserPreferences {
+	return &UserPreferences{
+		Notifications: NotificationsDM,
+		Coordinates:   true,
+	}
+}
+
+func (gm *GameManager) GetUserPreferences(userID string) *UserPreferences {
+	prefs, err := gm.store.GetUserPreferences(userID)
+	if err != nil {
+		if err != ErrNotFound {
+			gm.api.LogDebug("could not get preferences", "user", userID, "error", err.Error())
+		}
+		return defaultUserPreferences()
+	}
+
+	return prefs
+}
+
+// SetUserPreference validates and stores a single setting.
+func (gm *GameManager) SetUserPreference(userID, key, value string) error {
+	prefs := gm.GetUserPreferences(userID)
+
+	switch key {
+	case "notifications":
+		switch value {
+		case NotificationsDM, NotificationsThread, NotificationsOff:
+			prefs.Notifications = value
+		default:
+			return newUserError(&i18n.Message{
+				ID:    "chess.error.setting_notifications",
+				Other: "notifications must be one of {{.DM}}, {{.Thread}} or {{.Off}}",
+			}, map[string]interface{}{"DM": NotificationsDM, "Thread": NotificationsThread, "Off": NotificationsOff})
+		}
+	case "coordinates":
+		switch value {
+		case "on":
+			prefs.Coordinates = true
+		case "off":
+			prefs.Coordinates = false
+		default:
+			return newUserError(&i18n.Message{
+				ID:    "chess.error.setting_coordinates",
+				Other: "coordinates must be on or off",
+			}, nil)
+		}
+	case "theme":
+		value = strings.ToLower(value)
+		names := gm.getConfiguration().boardThemeNames()
+		if value != "default" && !containsString(names, value) {
+			return newUserError(&i18n.Message{
+				ID:    "chess.error.setting_theme",
+				Other: "theme must be default or one of {{.Names}}",
+			}, map[string]interface{}{"Names": strings.Join(names, ", ")})
+		}
+		prefs.Theme = value
+		if value == "default" {
+			prefs.Theme = ""
+		}
+	case "pieces":
+		value = strings.ToLower(value)
+		names := pieceSetNames()
+		if value != "default" && !containsString(names, value) {
+			return newUserError(&i18n.Message{
+				ID:    "chess.error.setting_pieces",
+				Other: "pieces must be default or one of {{.Names}}",
+			}, map[string]interface{}{"Names": strings.Join(names, ", ")})
+		}
+		prefs.Pieces = value
+		if value == "default" {
+			prefs.Pieces = ""
+		}
+	case "notation":
+		switch value {
+		case NotationLetters:
+			prefs.Notation = ""
+		case NotationFigurine:
+			prefs.Notation = value
+		default:
+			return newUserError(&i18n.Message{
+				ID:    "chess.error.setting_notation",
+				Other: "notation must be {{.Letters}} or {{.Figurine}}",
+			}, map[string]interface{}{"Letters": NotationLetters, "Figurine": NotationFigurine})
+		}
+	default:
+		return newUserError(&i18n.Message{
+			ID:    "chess.error.setting_unknown",
+			Other: "unknown setting {{.Key}}",
+		}, map[string]interface{}{"Key": key})
+	}
+
+	return gm.store.SaveUserPreferences(userID, prefs)
+}
+
+func (gm *GameManager) formatUserPreferences(l *i18n.Localizer, prefs *UserPreferences) string {
+	notation := prefs.Notation
+	if notation == "" {
+		notation = NotationLetters
+	}
+	title := gm.localize(l, &i18n.Message{
+		ID:    "chess.settings.title",
+		Other: "#### Your chess settings",
+	}, nil)
+	return fmt.Sprintf("%s\n\nnotifications: %s\ncoordinates: %s\ntheme: %s\npieces: %s\nnotation: %s\n",
+		title, prefs.Notifications, onOff(prefs.Coordinates), orDefault(prefs.Theme), orDefault(prefs.Pieces), notation)
+}
+
+func orDefault(s string) string {
+	if s == "" {
+		return "default"
+	}
+	return s
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+func onOff(b bool) string {
+	if b {
+		return "on"
+	}
+	return "off"
+}
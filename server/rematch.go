@@ -0,0 +1,199 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/mattermost/mattermost-plugin-api/i18n"
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+// OfferRematch records that player wants a rematch of the finished game.
+func (gm *GameManager) OfferRematch(id, player string) (*model.Post, error) {
+	game, unlock, err := gm.getLockedGame(id)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	err = checkRematch(game, player)
+	if err != nil {
+		return nil, err
+	}
+
+	if game.RematchOfferedBy != "" {
+		return nil, newUserError(&i18n.Message{
+			ID:    "chess.error.rematch_offered",
+			Other: "a rematch has already been offered",
+		}, nil)
+	}
+
+	game.RematchOfferedBy = player
+	err = gm.saveGame(game)
+	if err != nil {
+		return nil, err
+	}
+
+	return gm.gameToPost(game), nil
+}
+
+// AcceptRematch starts a new game with the colors swapped. Only the player the
+// rematch was offered to can accept it.
+func (gm *GameManager) AcceptRematch(id, player string) (*model.Post, error) {
+	game, unlock, err := gm.getLockedGame(id)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	err = checkRematch(game, player)
+	if err != nil {
+		return nil, err
+	}
+
+	if game.RematchOfferedBy == "" || game.RematchOfferedBy == player {
+		return nil, newUserError(&i18n.Message{
+			ID:    "chess.error.rematch_not_offered_by_opponent",
+			Other: "your opponent has not offered a rematch",
+		}, nil)
+	}
+
+	rematch, err := gm.createGame(GameMetadata{
+		WhiteID:     game.BlackID,
+		BlackID:     game.WhiteID,
+		Variant:     game.Variant,
+		TimeControl: game.TimeControl,
+		MoveLog:     game.MoveLog,
+		Display:     game.Display,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	game.RematchOfferedBy = ""
+	game.RematchGameID = rematch.ID
+	err = gm.saveGame(game)
+	if err != nil {
+		return nil, err
+	}
+
+	return gm.gameToPost(game), nil
+}
+
+// DeclineRematch withdraws or declines the rematch offer.
+func (gm *GameManager) DeclineRematch(id, player string) (*model.Post, error) {
+	game, unlock, err := gm.getLockedGame(id)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	err = checkRematch(game, player)
+	if err != nil {
+		return nil, err
+	}
+
+	if game.RematchOfferedBy == "" {
+		return nil, newUserError(&i18n.Message{
+			ID:    "chess.error.rematch_not_offered",
+			Other: "no rematch has been offered",
+		}, nil)
+	}
+
+	game.RematchOfferedBy = ""
+	err = gm.saveGame(game)
+	if err != nil {
+		return nil, err
+	}
+
+	return gm.gameToPost(game), nil
+}
+
+func checkRematch(game *Game, player string) error {
+	if !game.IsPlayer(player) {
+		return newUserError(messageNotPlaying, nil)
+	}
+
+	if !game.IsOver() {
+		return newUserError(&i18n.Message{
+			ID:    "chess.error.game_not_over",
+			Other: "the game is not over",
+		}, nil)
+	}
+
+	if game.RematchGameID != "" {
+		return newUserError(&i18n.Message{
+			ID:    "chess.error.rematch_started",
+			Other: "the rematch has already started",
+		}, nil)
+	}
+
+	return nil
+}
+
+// rematchAttachmentParts returns the text and the actions about the rematch to add
+// to the post of a finished game.
+func (gm *GameManager) rematchAttachmentParts(l *i18n.Localizer, game *Game) (string, []*model.PostAction) {
+	baseURL := gm.api.GetConfig().ServiceSettings.SiteURL
+	rematchURL := fmt.Sprintf("%s/plugins/%s/rematch/%s", *baseURL, manifest.Id, game.ID)
+
+	if game.RematchGameID != "" {
+		rematch, err := gm.store.GetGame(game.RematchGameID)
+		if err != nil || rematch.PostID == "" {
+			return "\n" + gm.localize(l, &i18n.Message{
+				ID:    "chess.rematch.started",
+				Other: "Rematch started.",
+			}, nil), nil
+		}
+		return "\n" + gm.localize(l, &i18n.Message{
+			ID:    "chess.rematch.started_link",
+			Other: "Rematch started: [go to the game]({{.URL}})",
+		}, map[string]interface{}{"URL": fmt.Sprintf("%s/_redirect/pl/%s", *baseURL, rematch.PostID)}), nil
+	}
+
+	if game.RematchOfferedBy != "" {
+		offeredBy := "unknown"
+		if user, appErr := gm.api.GetUser(game.RematchOfferedBy); appErr == nil {
+			offeredBy = user.Username
+		}
+
+		text := "\n" + gm.localize(l, &i18n.Message{
+			ID:    "chess.rematch.offered",
+			Other: "@{{.Username}} offered a rematch.",
+		}, map[string]interface{}{"Username": offeredBy})
+		return text, []*model.PostAction{
+			{
+				Type: "button",
+				Name: gm.localize(l, &i18n.Message{
+					ID:    "chess.rematch.button.accept",
+					Other: "Accept rematch",
+				}, nil),
+				Integration: &model.PostActionIntegration{
+					URL: rematchURL + "/accept",
+				},
+			},
+			{
+				Type: "button",
+				Name: gm.localize(l, &i18n.Message{
+					ID:    "chess.rematch.button.decline",
+					Other: "Decline rematch",
+				}, nil),
+				Integration: &model.PostActionIntegration{
+					URL: rematchURL + "/decline",
+				},
+			},
+		}
+	}
+
+	return "", []*model.PostAction{
+		{
+			Type: "button",
+			Name: gm.localize(l, &i18n.Message{
+				ID:    "chess.rematch.button.offer",
+				Other: "Rematch",
+			}, nil),
+			Integration: &model.PostActionIntegration{
+				URL: rematchURL,
+			},
+		},
+	}
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRematch(t *testing.T) {
+	const (
+		offer   = "offer"
+		accept  = "accept"
+		decline = "decline"
+	)
+	foolsMate := []string{"f3", "e5", "g4", "Qh4"}
+
+	for _, tc := range []struct {
+		name   string
+		played []string
+		// offeredByBlack makes black offer the rematch before the action.
+		offeredByBlack bool
+		// started marks the rematch as already started.
+		started     bool
+		action      string
+		byBlack     bool
+		notPlaying  bool
+		expectError bool
+		// pending is true when the offer is still pending after the action.
+		pending bool
+		rematch bool
+	}{
+		{name: "offer", played: foolsMate, action: offer, pending: true},
+		{name: "offer during the game", played: []string{"e4"}, action: offer, expectError: true},
+		{name: "offer by someone else", played: foolsMate, action: offer, notPlaying: true, expectError: true},
+		{name: "offer twice", played: foolsMate, offeredByBlack: true, action: offer, expectError: true},
+		{name: "offer after the rematch started", played: foolsMate, started: true, action: offer, expectError: true},
+		{name: "accept", played: foolsMate, offeredByBlack: true, action: accept, rematch: true},
+		{name: "accept your own offer", played: foolsMate, offeredByBlack: true, action: accept, byBlack: true, expectError: true},
+		{name: "accept without an offer", played: foolsMate, action: accept, expectError: true},
+		{name: "accept by someone else", played: foolsMate, offeredByBlack: true, action: accept, notPlaying: true, expectError: true},
+		{name: "decline", played: foolsMate, offeredByBlack: true, action: decline},
+		{name: "withdraw", played: foolsMate, offeredByBlack: true, action: decline, byBlack: true},
+		{name: "decline without an offer", played: foolsMate, action: decline, expectError: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			api := setupTestAPI(t)
+			gm, store := setupTestGameManager(t, api)
+			game := startTestGame(t, gm, tc.played...)
+			if tc.offeredByBlack {
+				game.RematchOfferedBy = game.BlackID
+			}
+			if tc.started {
+				game.RematchGameID = model.NewId()
+			}
+			require.NoError(t, gm.saveGame(game))
+
+			player := game.WhiteID
+			switch {
+			case tc.byBlack:
+				player = game.BlackID
+			case tc.notPlaying:
+				player = model.NewId()
+			}
+			var err error
+			switch tc.action {
+			case offer:
+				_, err = gm.OfferRematch(game.ID, player)
+			case accept:
+				_, err = gm.AcceptRematch(game.ID, player)
+			case decline:
+				_, err = gm.DeclineRematch(game.ID, player)
+			}
+
+			stored, getErr := store.GetGame(game.ID)
+			require.NoError(t, getErr)
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Equal(t, game.RematchOfferedBy, stored.RematchOfferedBy)
+				assert.Equal(t, game.RematchGameID, stored.RematchGameID)
+				return
+			}
+			require.NoError(t, err)
+
+			if tc.pending {
+				assert.Equal(t, player, stored.RematchOfferedBy)
+			} else {
+				assert.Empty(t, stored.RematchOfferedBy)
+			}
+
+			if !tc.rematch {
+				assert.Empty(t, stored.RematchGameID)
+				return
+			}
+			rematch, err := store.GetGame(stored.RematchGameID)
+			require.NoError(t, err)
+			assert.Equal(t, game.BlackID, rematch.WhiteID)
+			assert.Equal(t, game.WhiteID, rematch.BlackID)
+			assert.Equal(t, game.Variant, rematch.Variant)
+			assert.Empty(t, rematch.Moves())
+			assert.NotEmpty(t, rematch.PostID)
+		})
+	}
+}
@@ -0,0 +1,334 @@
+package main
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/gif"
+	"sort"
+	"sync"
+
+	"github.com/notnil/chess"
+	"github.com/pkg/errors"
+)
+
+const (
+	// defaultReplayFrameDelay is how long each position is shown, in hundredths of a second.
+	defaultReplayFrameDelay = 100
+	// replayFinalFrameFactor is how many times longer the final position is shown.
+	replayFinalFrameFactor = 3
+	replayImageSize        = 360
+)
+
+// replayRenders holds the users and games a replay is being drawn for, so each of
+// them only has one at a time.
+type replayRenders struct {
+	lock sync.Mutex
+	busy map[string]bool
+}
+
+func newReplayRenders() *replayRenders {
+	return &replayRenders{busy: map[string]bool{}}
+}
+
+// start reserves the keys, unless one of them is already reserved.
+func (r *replayRenders) start(keys ...string) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	for _, key := range keys {
+		if r.busy[key] {
+			return false
+		}
+	}
+	for _, key := range keys {
+		r.busy[key] = true
+	}
+	return true
+}
+
+func (r *replayRenders) finish(keys ...string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	for _, key := range keys {
+		delete(r.busy, key)
+	}
+}
+
+// renderReplayGIF draws every position of the game, with the last move highlighted,
+// as the frames of an animated GIF. The boards look like the PNG boards of the user,
+// and are drawn with the standard library only, like the GIF encoding.
+// The delay between the frames is in hundredths of a second.
+func renderReplayGIF(game *chess.Game, orientation chess.Color, prefs *UserPreferences, theme *BoardTheme, delay int) ([]byte, error) {
+	board, err := newReplayBoard(orientation, prefs, theme)
+	if err != nil {
+		return nil, err
+	}
+
+	positions := game.Positions()
+	moves := game.Moves()
+
+	frames := make([]image.Image, len(positions))
+	for i, position := range positions {
+		var lastMovement *chess.Move
+		if i > 0 {
+			lastMovement = moves[i-1]
+		}
+		frames[i] = board.draw(position, boardImageForPosition(position, lastMovement, orientation))
+	}
+
+	palette := replayPalette(frames)
+	animation := &gif.GIF{
+		Config: image.Config{
+			ColorModel: palette,
+			Width:      frames[0].Bounds().Dx(),
+			Height:     frames[0].Bounds().Dy(),
+		},
+	}
+
+	indexes := map[color.RGBA]uint8{}
+	for i, frame := range frames {
+		animation.Image = append(animation.Image, toPaletted(frame, palette, indexes))
+		if i == len(frames)-1 {
+			animation.Delay = append(animation.Delay, delay*replayFinalFrameFactor)
+		} else {
+			animation.Delay = append(animation.Delay, delay)
+		}
+	}
+
+	buf := &bytes.Buffer{}
+	if err := gif.EncodeAll(buf, animation); err != nil {
+		return nil, errors.Wrap(err, "could not encode the replay")
+	}
+	return buf.Bytes(), nil
+}
+
+// replayBoard draws the frames of a replay. What every frame shares, the squares,
+// the coordinates and the pieces scaled to the squares, is prepared once.
+type replayBoard struct {
+	orientation chess.Color
+	theme       *BoardTheme
+	squareSize  int
+	margin      int
+	background  *image.RGBA
+	pieces      map[chess.Piece]*image.RGBA
+}
+
+func newReplayBoard(orientation chess.Color, prefs *UserPreferences, theme *BoardTheme) (*replayBoard, error) {
+	sprites, err := getPieceSprites(prefs.Pieces)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not load the pieces")
+	}
+
+	b := &replayBoard{
+		orientation: orientation,
+		theme:       theme,
+		squareSize:  replayImageSize / 8,
+		pieces:      map[chess.Piece]*image.RGBA{},
+	}
+	if prefs.Coordinates {
+		// Keep the same proportion between the squares and the margin as the other boards.
+		b.squareSize = replayImageSize * boardSquareSize / (8*boardSquareSize + boardCoordinateMargin)
+		b.margin = replayImageSize - 8*b.squareSize
+	}
+
+	size := 8*b.squareSize + b.margin
+	b.background = image.NewRGBA(image.Rect(0, 0, size, size))
+	draw.Draw(b.background, b.background.Bounds(), image.NewUniform(theme.Light), image.Point{}, draw.Src)
+	for i := 0; i < 64; i++ {
+		sq := chess.Square(i)
+		draw.Draw(b.background, b.squareRect(sq), image.NewUniform(squareColor(sq, theme)), image.Point{}, draw.Src)
+	}
+	if b.margin > 0 {
+		b.drawCoordinates()
+	}
+
+	for _, piece := range []chess.Piece{
+		chess.WhiteKing, chess.WhiteQueen, chess.WhiteRook, chess.WhiteBishop, chess.WhiteKnight, chess.WhitePawn,
+		chess.BlackKing, chess.BlackQueen, chess.BlackRook, chess.BlackBishop, chess.BlackKnight, chess.BlackPawn,
+	} {
+		col, row := pieceIndex(piece)%len(spritePieceOrder), pieceIndex(piece)/len(spritePieceOrder)
+		sprite := image.Rect(col*pieceSpriteSize, row*pieceSpriteSize, (col+1)*pieceSpriteSize, (row+1)*pieceSpriteSize)
+		b.pieces[piece] = scaleDown(sprites, sprite, b.squareSize)
+	}
+
+	return b, nil
+}
+
+func (b *replayBoard) squareRect(sq chess.Square) image.Rectangle {
+	col, row := squarePosition(sq, b.orientation)
+	min := image.Pt(b.margin+col*b.squareSize, row*b.squareSize)
+	return image.Rectangle{Min: min, Max: min.Add(image.Pt(b.squareSize, b.squareSize))}
+}
+
+// draw returns the frame of the position, with the highlights of img.
+func (b *replayBoard) draw(position *chess.Position, img *BoardImage) *image.RGBA {
+	frame := image.NewRGBA(b.background.Bounds())
+	copy(frame.Pix, b.background.Pix)
+
+	fillSquare := func(sq chess.Square, c color.Color) {
+		draw.Draw(frame, b.squareRect(sq), image.NewUniform(c), image.Point{}, draw.Over)
+	}
+	for _, s := range []string{img.From, img.To} {
+		if sq, ok := strToSquareMap[s]; ok {
+			fillSquare(sq, withAlpha(b.theme.Highlight, highlightAlpha))
+		}
+	}
+	if sq, ok := strToSquareMap[img.Capture]; ok {
+		fillSquare(sq, withAlpha(b.theme.Warning, highlightAlpha))
+	}
+	if sq, ok := strToSquareMap[img.Check]; ok {
+		drawCheckMarker(frame, b.squareRect(sq), b.theme.Warning)
+	}
+
+	for sq, piece := range position.Board().SquareMap() {
+		draw.Draw(frame, b.squareRect(sq), b.pieces[piece], image.Point{}, draw.Over)
+	}
+
+	return frame
+}
+
+// drawCoordinates writes the rank labels on the left of the board and the file
+// labels below it, with the glyphs of replayGlyphs.
+func (b *replayBoard) drawCoordinates() {
+	scale := b.margin / 10
+	if scale < 1 {
+		scale = 1
+	}
+	ink := image.NewUniform(b.theme.Dark)
+
+	label := func(r rune, centerX, centerY int) {
+		glyph := replayGlyphs[r]
+		left := centerX - 3*scale/2
+		top := centerY - 5*scale/2
+		for y, line := range glyph {
+			for x, dot := range line {
+				if dot != '#' {
+					continue
+				}
+				min := image.Pt(left+x*scale, top+y*scale)
+				draw.Draw(b.background, image.Rectangle{Min: min, Max: min.Add(image.Pt(scale, scale))}, ink, image.Point{}, draw.Src)
+			}
+		}
+	}
+
+	for i := 0; i < 8; i++ {
+		rank := chess.Rank(7 - i)
+		file := chess.File(i)
+		if b.orientation == chess.Black {
+			rank = chess.Rank(i)
+			file = chess.File(7 - i)
+		}
+
+		center := i*b.squareSize + b.squareSize/2
+		label(rune(rank.String()[0]), b.margin/2, center)
+		label(rune(file.String()[0]), b.margin+center, 8*b.squareSize+b.margin/2)
+	}
+}
+
+// replayGlyphs are 3x5 dot glyphs of the coordinates, as there is no font in the
+// standard library.
+var replayGlyphs = map[rune][5]string{
+	'1': {" # ", "## ", " # ", " # ", "###"},
+	'2': {"## ", "  #", " # ", "#  ", "###"},
+	'3': {"## ", "  #", " # ", "  #", "## "},
+	'4': {"# #", "# #", "###", "  #", "  #"},
+	'5': {"###", "#  ", "## ", "  #", "## "},
+	'6': {" ##", "#  ", "###", "# #", "###"},
+	'7': {"###", "  #", " # ", " # ", " # "},
+	'8': {"###", "# #", "###", "# #", "###"},
+	'a': {"   ", " ##", "# #", "# #", " ##"},
+	'b': {"#  ", "## ", "# #", "# #", "## "},
+	'c': {"   ", " ##", "#  ", "#  ", " ##"},
+	'd': {"  #", " ##", "# #", "# #", " ##"},
+	'e': {"   ", " # ", "###", "#  ", " ##"},
+	'f': {" ##", "#  ", "## ", "#  ", "#  "},
+	'g': {" ##", "# #", " ##", "  #", "## "},
+	'h': {"#  ", "## ", "# #", "# #", "# #"},
+}
+
+// scaleDown returns the part r of src scaled down to size x size pixels. Each pixel
+// is the average of the pixels of src it covers, which keeps the edges of the
+// pieces smooth.
+func scaleDown(src image.Image, r image.Rectangle, size int) *image.RGBA {
+	dst := image.NewRGBA(image.Rect(0, 0, size, size))
+	for y := 0; y < size; y++ {
+		y0, y1 := r.Min.Y+y*r.Dy()/size, r.Min.Y+(y+1)*r.Dy()/size
+		for x := 0; x < size; x++ {
+			x0, x1 := r.Min.X+x*r.Dx()/size, r.Min.X+(x+1)*r.Dx()/size
+
+			var sr, sg, sb, sa, n uint32
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					cr, cg, cb, ca := src.At(sx, sy).RGBA()
+					sr, sg, sb, sa = sr+cr, sg+cg, sb+cb, sa+ca
+					n++
+				}
+			}
+			if n == 0 {
+				continue
+			}
+			// The sums are of premultiplied 16 bit values, like the pixels of dst.
+			dst.SetRGBA(x, y, color.RGBA{uint8(sr / n >> 8), uint8(sg / n >> 8), uint8(sb / n >> 8), uint8(sa / n >> 8)})
+		}
+	}
+	return dst
+}
+
+// replayPalette returns the 256 colors used the most in the frames. The boards are
+// mostly flat colors, so the rest are only found on the edges of the pieces.
+func replayPalette(frames []image.Image) color.Palette {
+	counts := map[color.RGBA]int{}
+	for _, frame := range frames {
+		bounds := frame.Bounds()
+		for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+			for x := bounds.Min.X; x < bounds.Max.X; x++ {
+				counts[color.RGBAModel.Convert(frame.At(x, y)).(color.RGBA)]++
+			}
+		}
+	}
+
+	colors := make([]color.RGBA, 0, len(counts))
+	for c := range counts {
+		colors = append(colors, c)
+	}
+	sort.Slice(colors, func(i, j int) bool {
+		if counts[colors[i]] != counts[colors[j]] {
+			return counts[colors[i]] > counts[colors[j]]
+		}
+		// Break the ties so the palette does not depend on the map order.
+		a, b := colors[i], colors[j]
+		return uint32(a.R)<<16|uint32(a.G)<<8|uint32(a.B) < uint32(b.R)<<16|uint32(b.G)<<8|uint32(b.B)
+	})
+	if len(colors) > 256 {
+		colors = colors[:256]
+	}
+
+	palette := make(color.Palette, len(colors))
+	for i, c := range colors {
+		palette[i] = c
+	}
+	return palette
+}
+
+// toPaletted converts the frame to the palette, using the closest color for the
+// colors missing from it. The indexes of the colors already converted are kept in
+// indexes, as the frames share most of their colors.
+func toPaletted(frame image.Image, palette color.Palette, indexes map[color.RGBA]uint8) *image.Paletted {
+	bounds := frame.Bounds()
+	paletted := image.NewPaletted(bounds, palette)
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			c := color.RGBAModel.Convert(frame.At(x, y)).(color.RGBA)
+			index, ok := indexes[c]
+			if !ok {
+				index = uint8(palette.Index(c))
+				indexes[c] = index
+			}
+			paletted.SetColorIndex(x, y, index)
+		}
+	}
+	return paletted
+}
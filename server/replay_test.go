@@ -0,0 +1,76 @@
+package main
+
+import (
+	"bytes"
+	"image/gif"
+	"testing"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/plugin/plugintest/mock"
+	"github.com/notnil/chess"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderReplayGIF(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		moves       []string
+		orientation chess.Color
+		coordinates bool
+	}{
+		{name: "no moves", orientation: chess.White},
+		{name: "checkmate", moves: []string{"e4", "e5", "Bc4", "Nc6", "Qh5", "Nf6", "Qxf7"}, orientation: chess.White},
+		{name: "from black with coordinates", moves: []string{"f3", "e5", "g4", "Qh4"}, orientation: chess.Black, coordinates: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			game := playTestGame(t, tc.moves...)
+			prefs := defaultUserPreferences()
+			prefs.Coordinates = tc.coordinates
+			theme := (&configuration{}).boardTheme("")
+
+			b, err := renderReplayGIF(game.Game, tc.orientation, prefs, theme, 50)
+			require.NoError(t, err)
+
+			replay, err := gif.DecodeAll(bytes.NewReader(b))
+			require.NoError(t, err)
+			require.Len(t, replay.Image, len(tc.moves)+1)
+			assert.Equal(t, replayImageSize, replay.Config.Width)
+			assert.Equal(t, replayImageSize, replay.Config.Height)
+			for i, delay := range replay.Delay {
+				if i == len(replay.Delay)-1 {
+					assert.Equal(t, 50*replayFinalFrameFactor, delay)
+				} else {
+					assert.Equal(t, 50, delay)
+				}
+			}
+		})
+	}
+}
+
+func TestShareReplayOneAtATime(t *testing.T) {
+	api := setupTestAPI(t)
+	gm, _ := setupTestGameManager(t, api)
+	game := startTestGame(t, gm, "f3", "e5", "g4", "Qh4")
+	other := startTestGame(t, gm, "f3", "e5", "g4", "Qh4")
+	other.WhiteID = game.WhiteID
+	require.NoError(t, gm.saveGame(other))
+
+	// The upload waits until the other requests were made.
+	release := make(chan time.Time)
+	api.On("UploadFile", mock.Anything, game.ChannelID, mock.Anything).WaitUntil(release).Return(&model.FileInfo{Id: model.NewId()}, nil).Once()
+
+	require.NoError(t, gm.ShareReplay(game.ID, game.WhiteID, game.ChannelID, ""))
+	assert.Error(t, gm.ShareReplay(game.ID, game.BlackID, game.ChannelID, ""), "same game")
+	assert.Error(t, gm.ShareReplay(other.ID, game.WhiteID, other.ChannelID, ""), "same user")
+	close(release)
+
+	assert.Eventually(t, func() bool {
+		if !gm.replays.start("user_"+game.WhiteID, "game_"+game.ID) {
+			return false
+		}
+		gm.replays.finish("user_"+game.WhiteID, "game_"+game.ID)
+		return true
+	}, 5*time.Second, 10*time.Millisecond)
+}
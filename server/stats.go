@@ -0,0 +1,198 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/mattermost/mattermost-plugin-api/i18n"
+	"github.com/notnil/chess"
+)
+
+const favouriteOpeningsShown = 3
+
+// PlayerStats aggregates the results of a list of archived games from the point of view of one player.
+type PlayerStats struct {
+	Games         int
+	WhiteWins     int
+	WhiteLosses   int
+	WhiteDraws    int
+	BlackWins     int
+	BlackLosses   int
+	BlackDraws    int
+	TotalPlies    int
+	Openings      map[string]int
+	LongestStreak int
+	CurrentStreak int
+}
+
+// computeStats expects games sorted from oldest to newest.
+func computeStats(userID string, games []*ArchivedGame) *PlayerStats {
+	stats := &PlayerStats{
+		Openings: map[string]int{},
+	}
+
+	for _, g := range games {
+		if g.Termination == terminationAborted {
+			continue
+		}
+
+		stats.Games++
+		stats.TotalPlies += g.Plies
+		if g.Opening != "" {
+			stats.Openings[g.Opening]++
+		}
+
+		isWhite := g.Color(userID) == chess.White
+		switch {
+		case g.Won(userID):
+			if isWhite {
+				stats.WhiteWins++
+			} else {
+				stats.BlackWins++
+			}
+			stats.CurrentStreak++
+			if stats.CurrentStreak > stats.LongestStreak {
+				stats.LongestStreak = stats.CurrentStreak
+			}
+		case g.Lost(userID):
+			if isWhite {
+				stats.WhiteLosses++
+			} else {
+				stats.BlackLosses++
+			}
+			stats.CurrentStreak = 0
+		default:
+			if isWhite {
+				stats.WhiteDraws++
+			} else {
+				stats.BlackDraws++
+			}
+			stats.CurrentStreak = 0
+		}
+	}
+
+	return stats
+}
+
+func (s *PlayerStats) Wins() int {
+	return s.WhiteWins + s.BlackWins
+}
+
+func (s *PlayerStats) Losses() int {
+	return s.WhiteLosses + s.BlackLosses
+}
+
+func (s *PlayerStats) Draws() int {
+	return s.WhiteDraws + s.BlackDraws
+}
+
+// AverageLength returns the average number of moves per game.
+func (s *PlayerStats) AverageLength() float64 {
+	if s.Games == 0 {
+		return 0
+	}
+	return float64(s.TotalPlies) / float64(s.Games) / 2
+}
+
+// FavouriteOpenings returns the most played openings, most played first.
+func (s *PlayerStats) FavouriteOpenings(n int) []string {
+	openings := []string{}
+	for o := range s.Openings {
+		openings = append(openings, o)
+	}
+
+	sort.Slice(openings, func(i, j int) bool {
+		if s.Openings[openings[i]] == s.Openings[openings[j]] {
+			return openings[i] < openings[j]
+		}
+		return s.Openings[openings[i]] > s.Openings[openings[j]]
+	})
+
+	if len(openings) > n {
+		openings = openings[:n]
+	}
+	return openings
+}
+
+var (
+	messageGamesPlayed = &i18n.Message{
+		ID:    "chess.stats.games",
+		Other: "Games played: {{.Count}}",
+	}
+	messageAverageLength = &i18n.Message{
+		ID:    "chess.stats.average_length",
+		Other: "Average game length: {{.Length}} moves",
+	}
+)
+
+func (gm *GameManager) formatStats(l *i18n.Localizer, username string, s *PlayerStats) string {
+	if s.Games == 0 {
+		return gm.localize(l, &i18n.Message{
+			ID:    "chess.stats.no_games",
+			Other: "@{{.Username}} has not finished any game yet.",
+		}, map[string]interface{}{"Username": username})
+	}
+
+	text := gm.localize(l, &i18n.Message{
+		ID:    "chess.stats.title",
+		Other: "#### Chess stats for @{{.Username}}",
+	}, map[string]interface{}{"Username": username}) + "\n\n"
+	text += gm.localize(l, &i18n.Message{
+		ID:    "chess.stats.header",
+		Other: "| | Wins | Losses | Draws |",
+	}, nil) + "\n|:--|:--|:--|:--|\n"
+	text += fmt.Sprintf("| %s | %d | %d | %d |\n", gm.localizeColor(l, chess.White), s.WhiteWins, s.WhiteLosses, s.WhiteDraws)
+	text += fmt.Sprintf("| %s | %d | %d | %d |\n", gm.localizeColor(l, chess.Black), s.BlackWins, s.BlackLosses, s.BlackDraws)
+	text += fmt.Sprintf("| %s | %d | %d | %d |\n\n", gm.localize(l, &i18n.Message{
+		ID:    "chess.stats.total",
+		Other: "Total",
+	}, nil), s.Wins(), s.Losses(), s.Draws())
+	text += gm.localize(l, messageGamesPlayed, map[string]interface{}{"Count": s.Games}) + "\n"
+	text += gm.localize(l, messageAverageLength, map[string]interface{}{"Length": fmt.Sprintf("%.1f", s.AverageLength())}) + "\n"
+	text += gm.localize(l, &i18n.Message{
+		ID:    "chess.stats.longest_streak",
+		Other: "Longest win streak: {{.Count}}",
+	}, map[string]interface{}{"Count": s.LongestStreak}) + "\n"
+	text += gm.localize(l, &i18n.Message{
+		ID:    "chess.stats.current_streak",
+		Other: "Current win streak: {{.Count}}",
+	}, map[string]interface{}{"Count": s.CurrentStreak}) + "\n"
+
+	openings := s.FavouriteOpenings(favouriteOpeningsShown)
+	if len(openings) > 0 {
+		text += gm.localize(l, &i18n.Message{
+			ID:    "chess.stats.openings",
+			Other: "Favourite openings:",
+		}, nil) + "\n"
+		for _, o := range openings {
+			text += fmt.Sprintf("- %s (%d)\n", o, s.Openings[o])
+		}
+	}
+
+	return text
+}
+
+func (gm *GameManager) formatHeadToHead(l *i18n.Localizer, username, opponentName string, s *PlayerStats) string {
+	if s.Games == 0 {
+		return gm.localize(l, &i18n.Message{
+			ID:    "chess.stats.no_head_to_head",
+			Other: "@{{.Username}} and @{{.Opponent}} have not finished any game against each other yet.",
+		}, map[string]interface{}{"Username": username, "Opponent": opponentName})
+	}
+
+	wins := &i18n.Message{
+		ID:    "chess.stats.head_to_head_wins",
+		Other: "@{{.Username}} wins: {{.Wins}} ({{.White}} with White, {{.Black}} with Black)",
+	}
+	text := fmt.Sprintf("#### @%s vs @%s\n\n", username, opponentName)
+	text += gm.localize(l, wins, map[string]interface{}{"Username": username, "Wins": s.Wins(), "White": s.WhiteWins, "Black": s.BlackWins}) + "\n"
+	text += gm.localize(l, wins, map[string]interface{}{"Username": opponentName, "Wins": s.Losses(), "White": s.BlackLosses, "Black": s.WhiteLosses}) + "\n"
+	text += gm.localize(l, &i18n.Message{
+		ID:    "chess.stats.draws",
+		Other: "Draws: {{.Count}}",
+	}, map[string]interface{}{"Count": s.Draws()}) + "\n"
+	text += gm.localize(l, messageGamesPlayed, map[string]interface{}{"Count": s.Games}) + "\n"
+	text += gm.localize(l, messageAverageLength, map[string]interface{}{"Length": fmt.Sprintf("%.1f", s.AverageLength())}) + "\n"
+
+	return text
+}
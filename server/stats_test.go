@@ -0,0 +1,124 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/notnil/chess"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComputeStats(t *testing.T) {
+	const me, opponent = "me", "opponent"
+	// archived returns a game of me against the opponent, oldest first.
+	archived := func(white bool, outcome chess.Outcome, plies int, opening string) *ArchivedGame {
+		g := &ArchivedGame{WhiteID: me, BlackID: opponent, Outcome: outcome, Plies: plies, Opening: opening}
+		if !white {
+			g.WhiteID, g.BlackID = opponent, me
+		}
+		return g
+	}
+	aborted := archived(true, chess.NoOutcome, 1, "A40 Queen's Pawn Game")
+	aborted.Termination = terminationAborted
+
+	for _, tc := range []struct {
+		name     string
+		games    []*ArchivedGame
+		expected PlayerStats
+		openings []string
+	}{
+		{
+			name:     "no games",
+			expected: PlayerStats{Openings: map[string]int{}},
+		},
+		{
+			name: "results by color",
+			games: []*ArchivedGame{
+				archived(true, chess.WhiteWon, 40, ""),
+				archived(false, chess.WhiteWon, 20, ""),
+				archived(false, chess.BlackWon, 30, ""),
+				archived(true, chess.Draw, 50, ""),
+			},
+			expected: PlayerStats{
+				Games:         4,
+				WhiteWins:     1,
+				WhiteDraws:    1,
+				BlackWins:     1,
+				BlackLosses:   1,
+				TotalPlies:    140,
+				Openings:      map[string]int{},
+				LongestStreak: 1,
+			},
+		},
+		{
+			name: "streaks",
+			games: []*ArchivedGame{
+				archived(true, chess.WhiteWon, 10, ""),
+				archived(true, chess.WhiteWon, 10, ""),
+				archived(true, chess.WhiteWon, 10, ""),
+				archived(true, chess.BlackWon, 10, ""),
+				archived(false, chess.BlackWon, 10, ""),
+				archived(false, chess.BlackWon, 10, ""),
+			},
+			expected: PlayerStats{
+				Games:         6,
+				WhiteWins:     3,
+				WhiteLosses:   1,
+				BlackWins:     2,
+				TotalPlies:    60,
+				Openings:      map[string]int{},
+				LongestStreak: 3,
+				CurrentStreak: 2,
+			},
+		},
+		{
+			name: "aborted games do not count",
+			games: []*ArchivedGame{
+				archived(true, chess.WhiteWon, 10, ""),
+				aborted,
+				archived(true, chess.WhiteWon, 10, ""),
+			},
+			expected: PlayerStats{
+				Games:         2,
+				WhiteWins:     2,
+				TotalPlies:    20,
+				Openings:      map[string]int{},
+				LongestStreak: 2,
+				CurrentStreak: 2,
+			},
+		},
+		{
+			name: "favourite openings",
+			games: []*ArchivedGame{
+				archived(true, chess.Draw, 10, "C50 Italian Game"),
+				archived(true, chess.Draw, 10, "B20 Sicilian Defense"),
+				archived(true, chess.Draw, 10, "C50 Italian Game"),
+				archived(true, chess.Draw, 10, "A40 Queen's Pawn Game"),
+				archived(true, chess.Draw, 10, "D00 Queen's Pawn Game"),
+				archived(true, chess.Draw, 10, ""),
+			},
+			expected: PlayerStats{
+				Games:      6,
+				WhiteDraws: 6,
+				TotalPlies: 60,
+				Openings: map[string]int{
+					"C50 Italian Game":      2,
+					"B20 Sicilian Defense":  1,
+					"A40 Queen's Pawn Game": 1,
+					"D00 Queen's Pawn Game": 1,
+				},
+			},
+			openings: []string{"C50 Italian Game", "A40 Queen's Pawn Game", "B20 Sicilian Defense"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			stats := computeStats(me, tc.games)
+			assert.Equal(t, tc.expected, *stats)
+			assert.Equal(t, append([]string{}, tc.openings...), stats.FavouriteOpenings(favouriteOpeningsShown))
+		})
+	}
+}
+
+func TestAverageLength(t *testing.T) {
+	assert.Equal(t, 0.0, (&PlayerStats{}).AverageLength())
+	assert.Equal(t, 25.0, (&PlayerStats{Games: 2, TotalPlies: 100}).AverageLength())
+}
@@ -0,0 +1,249 @@
+package main
+
+import (
+	"encoding/json"
+
+	"github.com/mattermost/mattermost-server/v5/plugin"
+	"github.com/pkg/errors"
+)
+
+const (
+	gameKeyPrefix        = "game_"
+	channelGameKeyPrefix = "channel_game_"
+	archivedGamePrefix   = "archive_"
+	indexKeyPrefix       = "index_"
+	preferencesPrefix    = "preferences_"
+
+	kvUpdateRetries = 5
+)
+
+// ErrNotFound is returned by a GameStore when the requested item does not exist.
+var ErrNotFound = errors.New("not found")
+
+// GameStore persists games, the archive of finished games, the indexes built on
+// top of them and the user preferences.
+type GameStore interface {
+	GetGame(id string) (*Game, error)
+	SaveGame(game *Game) error
+	GetChannelGameID(channelID string) (string, error)
+	SetChannelGameID(channelID, gameID string) error
+
+	GetArchivedGame(id string) (*ArchivedGame, error)
+	SaveArchivedGame(archived *ArchivedGame) error
+
+	// Indexes are lists of IDs, e.g. the games of a user. Adding an ID already
+	// in the index or removing one not in it does nothing.
+	GetIndex(name string) ([]string, error)
+	AddToIndex(name, id string) error
+	RemoveFromIndex(name, id string) error
+
+	GetUserPreferences(userID string) (*UserPreferences, error)
+	SaveUserPreferences(userID string, prefs *UserPreferences) error
+}
+
+func userGamesIndex(userID string) string {
+	return "user_games_" + userID
+}
+
+func activeGamesIndex(userID string) string {
+	return "active_games_" + userID
+}
+
+const allActiveGamesIndex = "all_active_games"
+
+// KVGameStore is the GameStore backed by the plugin KV store.
+type KVGameStore struct {
+	api plugin.API
+}
+
+func NewKVGameStore(api plugin.API) *KVGameStore {
+	return &KVGameStore{
+		api: api,
+	}
+}
+
+func (s *KVGameStore) get(key string) ([]byte, error) {
+	b, appErr := s.api.KVGet(key)
+	if appErr != nil {
+		return nil, appErr
+	}
+	if b == nil {
+		return nil, ErrNotFound
+	}
+	return b, nil
+}
+
+func (s *KVGameStore) set(key string, b []byte) error {
+	appErr := s.api.KVSet(key, b)
+	if appErr != nil {
+		return appErr
+	}
+	return nil
+}
+
+func (s *KVGameStore) GetGame(id string) (*Game, error) {
+	b, err := s.get(gameKeyPrefix + id)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeGameRecord(b)
+}
+
+func (s *KVGameStore) SaveGame(game *Game) error {
+	b, err := encodeGameRecord(game)
+	if err != nil {
+		return err
+	}
+
+	return s.set(gameKeyPrefix+game.ID, b)
+}
+
+func (s *KVGameStore) GetChannelGameID(channelID string) (string, error) {
+	b, err := s.get(channelGameKeyPrefix + channelID)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func (s *KVGameStore) SetChannelGameID(channelID, gameID string) error {
+	return s.set(channelGameKeyPrefix+channelID, []byte(gameID))
+}
+
+func (s *KVGameStore) GetArchivedGame(id string) (*ArchivedGame, error) {
+	b, err := s.get(archivedGamePrefix + id)
+	if err != nil {
+		return nil, err
+	}
+
+	archived := &ArchivedGame{}
+	err = json.Unmarshal(b, archived)
+	if err != nil {
+		return nil, err
+	}
+
+	return archived, nil
+}
+
+func (s *KVGameStore) SaveArchivedGame(archived *ArchivedGame) error {
+	b, err := json.Marshal(archived)
+	if err != nil {
+		return err
+	}
+
+	return s.set(archivedGamePrefix+archived.ID, b)
+}
+
+func (s *KVGameStore) GetIndex(name string) ([]string, error) {
+	b, appErr := s.api.KVGet(indexKeyPrefix + name)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	return parseIndex(b)
+}
+
+func (s *KVGameStore) AddToIndex(name, id string) error {
+	return s.updateIndex(name, func(ids []string) []string {
+		for _, existing := range ids {
+			if existing == id {
+				return nil
+			}
+		}
+		return append(ids, id)
+	})
+}
+
+func (s *KVGameStore) RemoveFromIndex(name, id string) error {
+	return s.updateIndex(name, func(ids []string) []string {
+		filtered := []string{}
+		for _, existing := range ids {
+			if existing != id {
+				filtered = append(filtered, existing)
+			}
+		}
+
+		if len(filtered) == len(ids) {
+			return nil
+		}
+		return filtered
+	})
+}
+
+// updateIndex applies update to the index using compare and set, retrying when
+// another instance changed the index in between. If update returns nil the index
+// is left untouched.
+func (s *KVGameStore) updateIndex(name string, update func(ids []string) []string) error {
+	key := indexKeyPrefix + name
+	for i := 0; i < kvUpdateRetries; i++ {
+		old, appErr := s.api.KVGet(key)
+		if appErr != nil {
+			return appErr
+		}
+
+		ids, err := parseIndex(old)
+		if err != nil {
+			return err
+		}
+
+		updated := update(ids)
+		if updated == nil {
+			return nil
+		}
+
+		b, err := json.Marshal(updated)
+		if err != nil {
+			return err
+		}
+
+		ok, appErr := s.api.KVCompareAndSet(key, old, b)
+		if appErr != nil {
+			return appErr
+		}
+		if ok {
+			return nil
+		}
+	}
+
+	return errors.Errorf("could not update index %s due to concurrent updates", name)
+}
+
+func parseIndex(b []byte) ([]string, error) {
+	ids := []string{}
+	if b == nil {
+		return ids, nil
+	}
+
+	err := json.Unmarshal(b, &ids)
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+func (s *KVGameStore) GetUserPreferences(userID string) (*UserPreferences, error) {
+	b, err := s.get(preferencesPrefix + userID)
+	if err != nil {
+		return nil, err
+	}
+
+	prefs := defaultUserPreferences()
+	err = json.Unmarshal(b, prefs)
+	if err != nil {
+		return nil, err
+	}
+
+	return prefs, nil
+}
+
+func (s *KVGameStore) SaveUserPreferences(userID string, prefs *UserPreferences) error {
+	b, err := json.Marshal(prefs)
+	if err != nil {
+		return err
+	}
+
+	return s.set(preferencesPrefix+userID, b)
+}
@@ -0,0 +1,147 @@
+package main
+
+import (
+	"sync"
+)
+
+// MemoryGameStore is a GameStore kept in memory, meant for tests. Games are stored
+// encoded, so callers never share state with the store.
+type MemoryGameStore struct {
+	lock         sync.Mutex
+	games        map[string][]byte
+	channelGames map[string]string
+	archive      map[string]ArchivedGame
+	indexes      map[string][]string
+	preferences  map[string]UserPreferences
+}
+
+func NewMemoryGameStore() *MemoryGameStore {
+	return &MemoryGameStore{
+		games:        map[string][]byte{},
+		channelGames: map[string]string{},
+		archive:      map[string]ArchivedGame{},
+		indexes:      map[string][]string{},
+		preferences:  map[string]UserPreferences{},
+	}
+}
+
+func (s *MemoryGameStore) GetGame(id string) (*Game, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	b, ok := s.games[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	return decodeGameRecord(b)
+}
+
+func (s *MemoryGameStore) SaveGame(game *Game) error {
+	b, err := encodeGameRecord(game)
+	if err != nil {
+		return err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.games[game.ID] = b
+	return nil
+}
+
+func (s *MemoryGameStore) GetChannelGameID(channelID string) (string, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	id, ok := s.channelGames[channelID]
+	if !ok {
+		return "", ErrNotFound
+	}
+
+	return id, nil
+}
+
+func (s *MemoryGameStore) SetChannelGameID(channelID, gameID string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.channelGames[channelID] = gameID
+	return nil
+}
+
+func (s *MemoryGameStore) GetArchivedGame(id string) (*ArchivedGame, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	archived, ok := s.archive[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	return &archived, nil
+}
+
+func (s *MemoryGameStore) SaveArchivedGame(archived *ArchivedGame) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.archive[archived.ID] = *archived
+	return nil
+}
+
+func (s *MemoryGameStore) GetIndex(name string) ([]string, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return append([]string{}, s.indexes[name]...), nil
+}
+
+func (s *MemoryGameStore) AddToIndex(name, id string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for _, existing := range s.indexes[name] {
+		if existing == id {
+			return nil
+		}
+	}
+
+	s.indexes[name] = append(s.indexes[name], id)
+	return nil
+}
+
+func (s *MemoryGameStore) RemoveFromIndex(name, id string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	filtered := []string{}
+	for _, existing := range s.indexes[name] {
+		if existing != id {
+			filtered = append(filtered, existing)
+		}
+	}
+
+	s.indexes[name] = filtered
+	return nil
+}
+
+func (s *MemoryGameStore) GetUserPreferences(userID string) (*UserPreferences, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	prefs, ok := s.preferences[userID]
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	return &prefs, nil
+}
+
+func (s *MemoryGameStore) SaveUserPreferences(userID string, prefs *UserPreferences) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.preferences[userID] = *prefs
+	return nil
+}
@@ -0,0 +1,160 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mattermost/mattermost-plugin-api/i18n"
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/notnil/chess"
+)
+
+// How the board of a game is shown, see GameMetadata.Display.
+const (
+	boardDisplayImage = "image"
+	// boardDisplayText shows the board as text instead of an image.
+	boardDisplayText = "text"
+	// boardDisplayBlindfold hides the board from the players until the game is over.
+	boardDisplayBlindfold = "blindfold"
+)
+
+var boardDisplays = []string{boardDisplayImage, boardDisplayText, boardDisplayBlindfold}
+
+// pieceListOrder is the order pieces are listed in, the most valuable first.
+var pieceListOrder = []chess.PieceType{chess.King, chess.Queen, chess.Rook, chess.Bishop, chess.Knight, chess.Pawn}
+
+// unicodeBoard draws the board as a grid of figurines, seen from the orientation
+// side, with the rank and file labels. Empty squares are dots.
+func unicodeBoard(board *chess.Board, orientation chess.Color) string {
+	sb := &strings.Builder{}
+	for row := 0; row < 8; row++ {
+		rank := chess.Rank(7 - row)
+		if orientation == chess.Black {
+			rank = chess.Rank(row)
+		}
+
+		sb.WriteString(rank.String())
+		for col := 0; col < 8; col++ {
+			file := chess.File(col)
+			if orientation == chess.Black {
+				file = chess.File(7 - col)
+			}
+
+			sb.WriteString(" ")
+			if piece := board.Piece(chess.Square(int(rank)*8 + int(file))); piece != chess.NoPiece {
+				sb.WriteString(piece.String())
+			} else {
+				sb.WriteString("·")
+			}
+		}
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString(" ")
+	for col := 0; col < 8; col++ {
+		file := chess.File(col)
+		if orientation == chess.Black {
+			file = chess.File(7 - col)
+		}
+		sb.WriteString(" " + file.String())
+	}
+	return sb.String()
+}
+
+// pieceList lists the pieces of each side in the notation, the most valuable first,
+// e.g. "White: Kg1, Qd1, a2" for screen readers.
+func (gm *GameManager) pieceList(l *i18n.Localizer, board *chess.Board, notation sanNotation) string {
+	lines := []string{}
+	for _, c := range []chess.Color{chess.White, chess.Black} {
+		pieces := []string{}
+		for _, pieceType := range pieceListOrder {
+			for sq := chess.A1; sq <= chess.H8; sq++ {
+				piece := board.Piece(sq)
+				if piece.Color() != c || piece.Type() != pieceType {
+					continue
+				}
+
+				letter := ""
+				if pieceType != chess.Pawn {
+					letter = notation.format(strings.ToUpper(pieceType.String()))
+				}
+				pieces = append(pieces, letter+sq.String())
+			}
+		}
+		lines = append(lines, gm.localize(l, &i18n.Message{
+			ID:    "chess.board.pieces",
+			Other: "{{.Color}}: {{.Pieces}}",
+		}, map[string]interface{}{"Color": gm.localizeColor(l, c), "Pieces": strings.Join(pieces, ", ")}))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// textBoard returns the board as markdown: the figurine grid in a code block,
+// followed by the piece list in the notation.
+func (gm *GameManager) textBoard(l *i18n.Localizer, board *chess.Board, orientation chess.Color, notation sanNotation) string {
+	return fmt.Sprintf("```\n%s\n```\n%s", unicodeBoard(board, orientation), gm.pieceList(l, board, notation))
+}
+
+// GetTextBoard returns the current position of the game as text, seen from the
+// side userID plays. Players of blindfold games cannot see it.
+func (gm *GameManager) GetTextBoard(id, userID string) (string, error) {
+	game, err := gm.getGame(id)
+	if err != nil {
+		return "", err
+	}
+
+	if !game.IsPlayer(userID) {
+		if _, appErr := gm.api.GetChannelMember(game.ChannelID, userID); appErr != nil {
+			return "", newUserError(messageCannotSee, nil)
+		}
+	} else if game.HidesBoard() {
+		return "", newUserError(messageBlindfoldBoard, nil)
+	}
+
+	l := gm.userLocalizer(userID)
+	text := gm.textBoard(l, game.Position().Board(), viewerOrientation(game, userID), gm.userNotation(userID))
+	if !game.IsOver() {
+		text += "\n" + gm.localize(l, messageTurn, map[string]interface{}{"Color": gm.localizeColor(l, game.Position().Turn())})
+	}
+	return text, nil
+}
+
+// SetDisplay changes how the board of the game is shown, and returns the updated
+// game post. Only the players can change it, and blindfold play can only be turned
+// on or off before the first move.
+func (gm *GameManager) SetDisplay(id, player, display string) (*model.Post, error) {
+	game, unlock, err := gm.getLockedGame(id)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	if !game.IsPlayer(player) {
+		return nil, newUserError(messageNotPlaying, nil)
+	}
+	if game.IsOver() {
+		return nil, newUserError(messageGameOver, nil)
+	}
+	if !containsString(boardDisplays, display) {
+		return nil, newUserError(&i18n.Message{
+			ID:    "chess.error.display",
+			Other: "the board can be shown as {{.Displays}}",
+		}, map[string]interface{}{"Displays": strings.Join(boardDisplays, ", ")})
+	}
+	if (display == boardDisplayBlindfold || game.Display == boardDisplayBlindfold) && len(game.Moves()) > 0 {
+		return nil, newUserError(&i18n.Message{
+			ID:    "chess.error.blindfold_change",
+			Other: "blindfold play can only be changed before the first move",
+		}, nil)
+	}
+
+	game.Display = display
+	if display == boardDisplayImage {
+		game.Display = ""
+	}
+	if err = gm.saveGame(game); err != nil {
+		return nil, err
+	}
+
+	return gm.gameToPost(game), nil
+}
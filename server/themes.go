@@ -0,0 +1,208 @@
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/notnil/chess"
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultBoardTheme = "brown"
+	defaultPieceSet   = "cburnett"
+)
+
+// BoardTheme is a set of colors to draw the board with. The highlights are blended
+// over the squares.
+type BoardTheme struct {
+	Name  string
+	Light color.Color
+	Dark  color.Color
+	// Highlight marks the squares of the last move.
+	Highlight color.Color
+	// Warning marks the captured piece and the king in check.
+	Warning color.Color
+}
+
+var builtinBoardThemes = map[string]*BoardTheme{
+	"brown": {
+		Name:      "brown",
+		Light:     color.NRGBA{235, 209, 166, 255},
+		Dark:      color.NRGBA{165, 117, 81, 255},
+		Highlight: color.NRGBA{0, 255, 255, 255},
+		Warning:   color.NRGBA{255, 0, 0, 255},
+	},
+	"green": {
+		Name:      "green",
+		Light:     color.NRGBA{238, 238, 210, 255},
+		Dark:      color.NRGBA{118, 150, 86, 255},
+		Highlight: color.NRGBA{255, 255, 0, 255},
+		Warning:   color.NRGBA{255, 0, 0, 255},
+	},
+	"blue": {
+		Name:      "blue",
+		Light:     color.NRGBA{222, 227, 230, 255},
+		Dark:      color.NRGBA{140, 162, 173, 255},
+		Highlight: color.NRGBA{255, 255, 0, 255},
+		Warning:   color.NRGBA{255, 0, 0, 255},
+	},
+	"gray": {
+		Name:      "gray",
+		Light:     color.NRGBA{224, 224, 224, 255},
+		Dark:      color.NRGBA{150, 150, 150, 255},
+		Highlight: color.NRGBA{0, 255, 255, 255},
+		Warning:   color.NRGBA{255, 0, 0, 255},
+	},
+}
+
+// parseBoardThemes reads the themes defined by the admin, one per line, as a name
+// followed by the light, dark, highlight and warning colors in hexadecimal. The
+// highlight and warning colors can be left out.
+func parseBoardThemes(definitions string) (map[string]*BoardTheme, error) {
+	themes := map[string]*BoardTheme{}
+	for i, line := range strings.Split(definitions, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) < 3 || len(fields) > 5 {
+			return nil, errors.Errorf("line %d: a theme is a name and 2 to 4 colors", i+1)
+		}
+
+		name := strings.ToLower(fields[0])
+		colors := []color.Color{nil, nil, builtinBoardThemes[defaultBoardTheme].Highlight, builtinBoardThemes[defaultBoardTheme].Warning}
+		for j, hex := range fields[1:] {
+			c, err := parseHexColor(hex)
+			if err != nil {
+				return nil, errors.Wrapf(err, "line %d", i+1)
+			}
+			colors[j] = c
+		}
+
+		themes[name] = &BoardTheme{
+			Name:      name,
+			Light:     colors[0],
+			Dark:      colors[1],
+			Highlight: colors[2],
+			Warning:   colors[3],
+		}
+	}
+
+	return themes, nil
+}
+
+func parseHexColor(hex string) (color.Color, error) {
+	c := color.NRGBA{A: 255}
+	if len(hex) != 7 || hex[0] != '#' {
+		return nil, errors.Errorf("invalid color %s, use the #rrggbb format", hex)
+	}
+	if _, err := fmt.Sscanf(hex, "#%02x%02x%02x", &c.R, &c.G, &c.B); err != nil {
+		return nil, errors.Errorf("invalid color %s, use the #rrggbb format", hex)
+	}
+	return c, nil
+}
+
+// boardTheme returns the theme with the given name, or the default one if there is
+// no such theme.
+func (c *configuration) boardTheme(name string) *BoardTheme {
+	for _, n := range []string{name, c.DefaultBoardTheme} {
+		if theme, ok := c.boardThemes[strings.ToLower(n)]; ok {
+			return theme
+		}
+		if theme, ok := builtinBoardThemes[strings.ToLower(n)]; ok {
+			return theme
+		}
+	}
+	return builtinBoardThemes[defaultBoardTheme]
+}
+
+// boardThemeNames returns the names of the available themes, sorted.
+func (c *configuration) boardThemeNames() []string {
+	names := []string{}
+	for name := range builtinBoardThemes {
+		if _, ok := c.boardThemes[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	for name := range c.boardThemes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// pieceSetSource is a piece set as generated by build/sprites.
+type pieceSetSource struct {
+	SVG [12]string
+	PNG string
+}
+
+var (
+	pieceSprites     = map[string]image.Image{}
+	pieceSpritesLock sync.Mutex
+)
+
+// getPieceSet returns the name of the set, or of the default one if there is no
+// such set.
+func getPieceSet(name string) string {
+	if _, ok := pieceSetSources[name]; ok {
+		return name
+	}
+	return defaultPieceSet
+}
+
+// pieceSetNames returns the names of the available piece sets, sorted.
+func pieceSetNames() []string {
+	names := []string{}
+	for name := range pieceSetSources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// pieceIndex returns the position of the piece in the sources of a set.
+func pieceIndex(piece chess.Piece) int {
+	index := spritePieceOrder[piece.Type()]
+	if piece.Color() == chess.Black {
+		index += len(spritePieceOrder)
+	}
+	return index
+}
+
+// pieceSVG returns the markup of the piece in the set, drawn in a 45x45 box.
+func pieceSVG(set string, piece chess.Piece) string {
+	return pieceSetSources[getPieceSet(set)].SVG[pieceIndex(piece)]
+}
+
+// getPieceSprites returns the decoded sprite sheet of the set.
+func getPieceSprites(set string) (image.Image, error) {
+	set = getPieceSet(set)
+
+	pieceSpritesLock.Lock()
+	defer pieceSpritesLock.Unlock()
+
+	if sprites, ok := pieceSprites[set]; ok {
+		return sprites, nil
+	}
+
+	b, err := base64.StdEncoding.DecodeString(pieceSetSources[set].PNG)
+	if err != nil {
+		return nil, err
+	}
+	sprites, err := png.Decode(bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+
+	pieceSprites[set] = sprites
+	return sprites, nil
+}